@@ -2,6 +2,7 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -11,6 +12,9 @@ import (
 // Ensure interface compatibility
 var _ error = &Error{}
 
+// statusInternalServerError is the HTTP status code returned by Status(), when no status code is set
+const statusInternalServerError = 500
+
 type wrappedAs string
 
 const (
@@ -23,6 +27,8 @@ type Error struct {
 	wrappedErr error
 	wrappedAs  wrappedAs
 	args       []any
+	statusCode int
+	retryable  bool
 
 	fileName   string
 	funcName   string
@@ -35,6 +41,8 @@ func New(msg string, args ...any) error {
 		wrappedErr: nil,
 		wrappedAs:  wrappedAsMessage,
 		args:       args,
+		statusCode: 0,
+		retryable:  false,
 
 		fileName:   "",
 		funcName:   "",
@@ -118,6 +126,37 @@ func WrapWithMessage(err error, msg string, args ...any) error {
 	return e
 }
 
+// FromPanic converts a recovered panic value into an error, capturing the caller frame for the trace.
+// If the value is an error, then it's wrapped, otherwise the value is converted to a string.
+// It returns nil if the value is nil i.e. no panic occurred.
+//
+// Example usage:
+//
+//	defer func() {
+//		if err := errors.FromPanic(recover()); err != nil {
+//			fmt.Println("Recovered from panic:", errors.Trace(err))
+//		}
+//	}()
+func FromPanic(r any) error {
+	if r == nil {
+		return nil
+	}
+
+	e := &Error{
+		msg:        "",
+		wrappedErr: nil,
+		wrappedAs:  wrappedAsMessage,
+	}
+	if err, ok := r.(error); ok {
+		e.msg = "recovered panic: " + err.Error()
+		e.wrappedErr = err
+	} else {
+		e.msg = fmt.Sprint(r)
+	}
+	applyCaller(e)
+	return e
+}
+
 func Cause(err error) error {
 	for err != nil {
 		wrappedErr := errors.Unwrap(err)
@@ -146,7 +185,18 @@ func Trace(err error) string {
 	return strings.Join(traces, "~>")
 }
 
+// Args returns the arguments of the error chain, in which values marked as Sensitive() are redacted i.e. "***"
 func Args(err error) []any {
+	return getArgs(err, false)
+}
+
+// Unredacted is the same as Args(), but returns the original values of arguments marked as Sensitive().
+// NOTE: This should only be used for debugging, as the values can contain secrets
+func Unredacted(err error) []any {
+	return getArgs(err, true)
+}
+
+func getArgs(err error, unredacted bool) []any {
 	var args []any
 	for err != nil {
 		var e *Error
@@ -154,12 +204,97 @@ func Args(err error) []any {
 			break
 		}
 
-		args = append(args, e.args...)
+		for _, arg := range e.args {
+			if sv, ok := arg.(sensitiveValue); ok {
+				if unredacted {
+					arg = sv.value
+				} else {
+					arg = redacted
+				}
+			}
+			args = append(args, arg)
+		}
 		err = e.wrappedErr
 	}
 	return args
 }
 
+// WithStatus wraps the provided error with a HTTP status code, which can be retrieved using Status().
+// The error message is not changed
+func WithStatus(err error, statusCode int) error {
+	if err == nil {
+		return nil
+	}
+
+	e := &Error{
+		msg:        "",
+		wrappedErr: err,
+		wrappedAs:  wrappedAsDefault,
+		statusCode: statusCode,
+	}
+	applyCaller(e)
+	return e
+}
+
+// Status returns the nearest HTTP status code in the error chain, which was set using WithStatus().
+// If no status code is set, then 500 (Internal Server Error) and false are returned
+func Status(err error) (int, bool) {
+	for err != nil {
+		var e *Error
+		if !As(err, &e) {
+			break
+		}
+
+		if e.statusCode != 0 {
+			return e.statusCode, true
+		}
+		err = e.wrappedErr
+	}
+	return statusInternalServerError, false
+}
+
+// StatusOr returns the nearest HTTP status code in the error chain, which was set using WithStatus(),
+// otherwise the default status code
+func StatusOr(err error, def int) int {
+	if statusCode, ok := Status(err); ok {
+		return statusCode
+	}
+	return def
+}
+
+// MarkRetryable wraps the provided error, marking it as a transient error which can be retried.
+// The error message is not changed
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	e := &Error{
+		msg:        "",
+		wrappedErr: err,
+		wrappedAs:  wrappedAsDefault,
+		retryable:  true,
+	}
+	applyCaller(e)
+	return e
+}
+
+// IsRetryable reports whether any error in the error chain was marked as retryable using MarkRetryable()
+func IsRetryable(err error) bool {
+	for err != nil {
+		var e *Error
+		if !As(err, &e) {
+			break
+		}
+
+		if e.retryable {
+			return true
+		}
+		err = e.wrappedErr
+	}
+	return false
+}
+
 func applyCaller(e *Error) {
 	stack := make([]uintptr, 4)
 	count := runtime.Callers(3, stack)
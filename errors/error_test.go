@@ -1,8 +1,13 @@
 package errors
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
 )
 
 func Test_New(t *testing.T) {
@@ -59,3 +64,137 @@ func Test_New(t *testing.T) {
 	args = Args(e2)
 	t.Log("Args:", args)
 }
+
+func Test_Status(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantOk     bool
+		wantMsg    string
+	}{
+		{
+			name:       "nil error",
+			err:        nil,
+			wantStatus: 500,
+			wantOk:     false,
+		},
+		{
+			name:       "external error",
+			err:        errors.New("external error"),
+			wantStatus: 500,
+			wantOk:     false,
+			wantMsg:    "external error",
+		},
+		{
+			name:       "without a status",
+			err:        Wrap(New("not found"), "wrapped"),
+			wantStatus: 500,
+			wantOk:     false,
+			wantMsg:    "not found",
+		},
+		{
+			name:       "with a status",
+			err:        WithStatus(New("not found"), 404),
+			wantStatus: 404,
+			wantOk:     true,
+			wantMsg:    "not found",
+		},
+		{
+			name:       "nearest status",
+			err:        Wrap(WithStatus(WithStatus(errors.New("external error"), 500), 404), "wrapped"),
+			wantStatus: 404,
+			wantOk:     true,
+			wantMsg:    "external error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, ok := Status(tt.err)
+			testhelpers.AssertEqual(t, status, tt.wantStatus)
+			testhelpers.AssertEqual(t, ok, tt.wantOk)
+			if tt.wantOk {
+				testhelpers.AssertEqual(t, StatusOr(tt.err, 418), tt.wantStatus)
+			} else {
+				testhelpers.AssertEqual(t, StatusOr(tt.err, 418), 418)
+			}
+			if tt.err != nil {
+				testhelpers.AssertEqual(t, tt.err.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func Test_IsRetryable(t *testing.T) {
+	errExternal := errors.New("external error")
+	testhelpers.AssertEqual(t, IsRetryable(nil), false)
+	testhelpers.AssertEqual(t, IsRetryable(errExternal), false)
+	testhelpers.AssertEqual(t, IsRetryable(Wrap(errExternal, "wrapped")), false)
+	testhelpers.AssertEqual(t, IsRetryable(MarkRetryable(errExternal)), true)
+	testhelpers.AssertEqual(t, IsRetryable(Wrap(MarkRetryable(New("error")), "wrapped")), true)
+	testhelpers.AssertEqual(t, MarkRetryable(errExternal).Error(), "external error")
+	testhelpers.AssertEqual(t, Is(MarkRetryable(errExternal), errExternal), true)
+}
+
+func Test_Sensitive(t *testing.T) {
+	err := Wrap(New("invalid credentials",
+		"user", "jane",
+		"password", Sensitive("secret"),
+	), "wrapped",
+		"token", Sensitive("abc"),
+	)
+	testhelpers.AssertEqual(t, Args(err), []any{"token", "***", "user", "jane", "password", "***"})
+	testhelpers.AssertEqual(t, Unredacted(err), []any{"token", "abc", "user", "jane", "password", "secret"})
+
+	b, jsonErr := json.Marshal(Sensitive("secret"))
+	testhelpers.AssertNoError(t, jsonErr)
+	testhelpers.AssertEqual(t, string(b), `"***"`)
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%q", "%d", "%x"} {
+		testhelpers.AssertEqual(t, fmt.Sprintf(verb, Sensitive("secret")), "***")
+	}
+	testhelpers.AssertEqual(t, fmt.Sprintf("%d", Sensitive(1234)), "***")
+}
+
+func Test_FromPanic(t *testing.T) {
+	errPanic := errors.New("panic error")
+	tests := []struct {
+		name    string
+		r       any
+		wantMsg string
+		wantErr error
+	}{
+		{
+			name:    "error",
+			r:       errPanic,
+			wantMsg: "recovered panic: panic error",
+			wantErr: errPanic,
+		},
+		{
+			name:    "non-error",
+			r:       "panic string",
+			wantMsg: "panic string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			func() {
+				defer func() {
+					err = FromPanic(recover())
+				}()
+				panic(tt.r)
+			}()
+
+			testhelpers.AssertEqual(t, err.Error(), tt.wantMsg)
+			if tt.wantErr != nil {
+				testhelpers.AssertEqual(t, Is(err, tt.wantErr), true)
+			}
+
+			var e *Error
+			testhelpers.AssertEqual(t, As(err, &e), true)
+			testhelpers.AssertEqual(t, strings.Contains(Trace(err), "Test_FromPanic"), true)
+		})
+	}
+
+	testhelpers.AssertEqual(t, FromPanic(nil), nil)
+}
@@ -0,0 +1,43 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+)
+
+const redacted = "***"
+
+// sensitiveValue wraps an argument value, which should not be exposed e.g. in logs.
+// It's redacted when formatted or encoded as JSON, in case it's used outside of Args()
+type sensitiveValue struct {
+	value any
+}
+
+// Sensitive marks an argument value as sensitive e.g. a token or password, so that it's redacted i.e. "***"
+// when returned by Args(). The original value can be retrieved using Unredacted().
+//
+// Example usage:
+//
+//	err := errors.New("invalid credentials", "user", user, "password", errors.Sensitive(password))
+func Sensitive(value any) any {
+	return sensitiveValue{
+		value: value,
+	}
+}
+
+// Format implements fmt.Formatter, so the value is redacted for every verb e.g. "%d" or "%x"
+func (sv sensitiveValue) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, redacted)
+}
+
+func (sv sensitiveValue) String() string {
+	return redacted
+}
+
+func (sv sensitiveValue) GoString() string {
+	return redacted
+}
+
+func (sv sensitiveValue) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + redacted + `"`), nil
+}
@@ -0,0 +1,21 @@
+package helpers
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Clamp returns the value bounded to the inclusive range [lo, hi].
+// It panics if lo is greater than hi
+//
+// Example usage:
+//
+//	v1 := Clamp(5, 1, 10)  // Returns 5
+//	v2 := Clamp(-5, 1, 10) // Returns 1
+//	v3 := Clamp(50, 1, 10) // Returns 10
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if lo > hi {
+		panic(fmt.Sprintf("invalid clamp range: lo %v is greater than hi %v", lo, hi))
+	}
+	return min(max(v, lo), hi)
+}
@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Clamp(t *testing.T) {
+	tests := []struct {
+		name string
+		v    int
+		lo   int
+		hi   int
+		want int
+	}{
+		{
+			name: "below range",
+			v:    -5,
+			lo:   1,
+			hi:   10,
+			want: 1,
+		},
+		{
+			name: "in range",
+			v:    5,
+			lo:   1,
+			hi:   10,
+			want: 5,
+		},
+		{
+			name: "above range",
+			v:    50,
+			lo:   1,
+			hi:   10,
+			want: 10,
+		},
+		{
+			name: "equal bounds",
+			v:    50,
+			lo:   10,
+			hi:   10,
+			want: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Clamp(tt.v, tt.lo, tt.hi)
+			testhelpers.AssertEqual(t, got, tt.want)
+		})
+	}
+}
+
+func Test_Clamp_InvalidRange(t *testing.T) {
+	defer func() {
+		testhelpers.AssertEqual(t, recover() != nil, true)
+	}()
+	Clamp(5, 10, 1)
+}
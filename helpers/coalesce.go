@@ -0,0 +1,18 @@
+package helpers
+
+// Coalesce returns the first value which is not the zero value of its type.
+// If all the values are the zero value (or no values are provided),
+// then the zero value is returned
+//
+// Example usage:
+//
+//	v := Coalesce("", "default", "other") // Returns "default"
+func Coalesce[T comparable](vals ...T) T {
+	var zero T
+	for _, v := range vals {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
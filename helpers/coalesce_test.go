@@ -0,0 +1,37 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Coalesce(t *testing.T) {
+	tests := []struct {
+		name string
+		vals []string
+		want string
+	}{
+		{
+			name: "no values",
+			vals: nil,
+			want: "",
+		},
+		{
+			name: "all zero values",
+			vals: []string{"", "", ""},
+			want: "",
+		},
+		{
+			name: "first non-zero value",
+			vals: []string{"", "first", "second"},
+			want: "first",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Coalesce(tt.vals...)
+			testhelpers.AssertEqual(t, got, tt.want)
+		})
+	}
+}
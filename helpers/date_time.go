@@ -1,12 +1,24 @@
 package helpers
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 const (
 	layoutDateTime = "2006-01-02 15:04:05"
 	zeroDateTime   = "0000-00-00 00:00:00"
 )
 
+// defaultParseLayouts are the layouts used by ParseAny, when no layouts are provided
+var defaultParseLayouts = []string{
+	time.RFC3339Nano,
+	layoutDateTime,
+	"2006-01-02T15:04:05",
+	time.DateOnly,
+}
+
 // FormatAsDateTime formats a given time.Time value into a string
 // representation in the format "YYYY-MM-DD HH:MM:SS" based on the
 // current local time zone
@@ -17,13 +29,87 @@ func FormatAsDateTime(t time.Time) string {
 	return t.Format(layoutDateTime)
 }
 
+// FormatAsDateTimeIn formats a given time.Time value into a string
+// representation in the format "YYYY-MM-DD HH:MM:SS" based on the
+// provided time zone
+func FormatAsDateTimeIn(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return zeroDateTime
+	}
+	return t.In(loc).Format(layoutDateTime)
+}
+
 // ParseAsDateTime parses a string representation of date and time
 // in the format "YYYY-MM-DD HH:MM:SS" into a time.Time value
 // based on the local time zone
 func ParseAsDateTime(tt string) time.Time {
-	t, err := time.ParseInLocation(layoutDateTime, tt, time.Local)
+	t, err := ParseAsDateTimeIn(tt, time.Local)
 	if err != nil {
 		return time.Time{}
 	}
 	return t
 }
+
+// ParseAsDateTimeIn parses a string representation of date and time
+// in the format "YYYY-MM-DD HH:MM:SS" into a time.Time value
+// based on the provided time zone
+func ParseAsDateTimeIn(tt string, loc *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(layoutDateTime, tt, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse the date time %q: %w", tt, err)
+	}
+	return t, nil
+}
+
+// ParseAny parses a string representation of date and time by trying each layout in order,
+// returning the first successful result. Layouts without a time zone are parsed
+// based on the local time zone.
+// If no layouts are provided, then RFC3339 (with optional fractional seconds), "YYYY-MM-DD HH:MM:SS",
+// "YYYY-MM-DDTHH:MM:SS" and "YYYY-MM-DD" are tried.
+// An error is returned if none of the layouts match
+//
+// Example usage:
+//
+//	t, err := ParseAny("2024-12-20T12:00:00Z")
+//	if err != nil {
+//		log.Fatalf("Error parsing date time: %v", err)
+//	}
+func ParseAny(s string, layouts ...string) (time.Time, error) {
+	if len(layouts) == 0 {
+		layouts = defaultParseLayouts
+	}
+
+	var errs []error
+	for _, layout := range layouts {
+		t, err := time.ParseInLocation(layout, s, time.Local)
+		if err == nil {
+			return t, nil
+		}
+		errs = append(errs, err)
+	}
+	return time.Time{}, fmt.Errorf("unable to parse %q using any of the layouts %q: %w", s, layouts, errors.Join(errs...))
+}
+
+// StartOfDay returns the start of the day i.e. 00:00:00, based on the time zone of the time
+func StartOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfDay returns the end of the day i.e. 23:59:59, based on the time zone of the time
+func EndOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 23, 59, 59, 0, t.Location())
+}
+
+// StartOfHour returns the start of the hour i.e. HH:00:00, based on the time zone of the time
+func StartOfHour(t time.Time) time.Time {
+	// NOTE: Subtracting (instead of using time.Date()) ensures the correct hour is returned
+	// when the wall clock is repeated during a daylight saving time transition
+	return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+}
+
+// StartOfMinute returns the start of the minute i.e. HH:MM:00, based on the time zone of the time
+func StartOfMinute(t time.Time) time.Time {
+	return t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+}
@@ -0,0 +1,154 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_ParseAny(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		layouts []string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "RFC3339",
+			s:    "2024-12-20T12:30:45Z",
+			want: time.Date(2024, 12, 20, 12, 30, 45, 0, time.UTC),
+		},
+		{
+			name: "RFC3339 with fractional seconds",
+			s:    "2024-12-20T12:30:45.5Z",
+			want: time.Date(2024, 12, 20, 12, 30, 45, 500000000, time.UTC),
+		},
+		{
+			name: "date time",
+			s:    "2024-12-20 12:30:45",
+			want: time.Date(2024, 12, 20, 12, 30, 45, 0, time.Local),
+		},
+		{
+			name: "date only",
+			s:    "2024-12-20",
+			want: time.Date(2024, 12, 20, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:    "custom layout",
+			s:       "20/12/2024",
+			layouts: []string{"02/01/2006"},
+			want:    time.Date(2024, 12, 20, 0, 0, 0, 0, time.Local),
+		},
+		{
+			name:    "custom layout does not use the default layouts",
+			s:       "2024-12-20",
+			layouts: []string{"02/01/2006"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			s:       "20th of December",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAny(tt.s, tt.layouts...)
+			testhelpers.AssertEqual(t, err != nil, tt.wantErr)
+			testhelpers.AssertEqual(t, got.Equal(tt.want), true)
+		})
+	}
+}
+
+func Test_FormatAsDateTimeIn(t *testing.T) {
+	tm := time.Date(2024, 12, 20, 12, 30, 45, 0, time.UTC)
+	testhelpers.AssertEqual(t, FormatAsDateTimeIn(tm, time.UTC), "2024-12-20 12:30:45")
+	testhelpers.AssertEqual(t, FormatAsDateTimeIn(tm, time.FixedZone("UTC+2", 2*60*60)), "2024-12-20 14:30:45")
+	testhelpers.AssertEqual(t, FormatAsDateTimeIn(time.Time{}, time.UTC), "0000-00-00 00:00:00")
+}
+
+func Test_ParseAsDateTimeIn(t *testing.T) {
+	s := "2024-12-20 12:30:45"
+
+	inUTC, err := ParseAsDateTimeIn(s, time.UTC)
+	testhelpers.AssertNoError(t, err)
+
+	inOffset, err := ParseAsDateTimeIn(s, time.FixedZone("UTC+2", 2*60*60))
+	testhelpers.AssertNoError(t, err)
+
+	testhelpers.AssertEqual(t, inUTC.Equal(inOffset), false)
+	testhelpers.AssertEqual(t, inUTC.Sub(inOffset), 2*time.Hour)
+
+	_, err = ParseAsDateTimeIn("2024-12-20", time.UTC)
+	testhelpers.AssertError(t, err)
+}
+
+func Test_StartOfDayAndEndOfDay(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	testhelpers.AssertNoError(t, err)
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "midday",
+			t:         time.Date(2024, 12, 20, 12, 30, 45, 500, time.UTC),
+			wantStart: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 12, 20, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:      "midnight",
+			t:         time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 12, 20, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:      "one second before midnight",
+			t:         time.Date(2024, 12, 20, 23, 59, 59, 0, time.UTC),
+			wantStart: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 12, 20, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:      "daylight saving time starts",
+			t:         time.Date(2024, 3, 10, 15, 0, 0, 0, newYork),
+			wantStart: time.Date(2024, 3, 10, 5, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 3, 11, 3, 59, 59, 0, time.UTC),
+		},
+		{
+			name:      "daylight saving time ends",
+			t:         time.Date(2024, 11, 3, 15, 0, 0, 0, newYork),
+			wantStart: time.Date(2024, 11, 3, 4, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 11, 4, 4, 59, 59, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := StartOfDay(tt.t)
+			testhelpers.AssertEqual(t, start.Equal(tt.wantStart), true)
+			testhelpers.AssertEqual(t, start.Location(), tt.t.Location())
+
+			end := EndOfDay(tt.t)
+			testhelpers.AssertEqual(t, end.Equal(tt.wantEnd), true)
+			testhelpers.AssertEqual(t, end.Location(), tt.t.Location())
+		})
+	}
+}
+
+func Test_StartOfHourAndStartOfMinute(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	testhelpers.AssertNoError(t, err)
+
+	tm := time.Date(2024, 12, 20, 12, 30, 45, 500, time.UTC)
+	testhelpers.AssertEqual(t, StartOfHour(tm), time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC))
+	testhelpers.AssertEqual(t, StartOfMinute(tm), time.Date(2024, 12, 20, 12, 30, 0, 0, time.UTC))
+
+	// The wall clock hour 01:00 occurs twice when daylight saving time ends i.e. 05:00 UTC and 06:00 UTC
+	tm = time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC).In(newYork)
+	testhelpers.AssertEqual(t, StartOfHour(tm).Equal(time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC)), true)
+}
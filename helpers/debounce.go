@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce returns a trigger function, which delays calling fn until the duration has elapsed
+// since the last time trigger was called, and a cancel function, which stops any pending call
+// and releases the underlying timer. Once cancelled, calling trigger is a no-op.
+// Both functions are safe to call concurrently
+//
+// Example usage:
+//
+//	trigger, cancel := Debounce(100*time.Millisecond, func() {
+//		fmt.Println("reloading config")
+//	})
+//	defer cancel()
+//
+//	trigger()
+//	trigger() // Only a single "reloading config" is printed after 100ms
+func Debounce(d time.Duration, fn func()) (trigger func(), cancel func()) {
+	var (
+		mu        sync.Mutex
+		timer     *time.Timer
+		cancelled bool
+	)
+	trigger = func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if cancelled {
+			return
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(d, fn)
+	}
+	cancel = func() {
+		mu.Lock()
+		defer mu.Unlock()
+
+		cancelled = true
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+	}
+	return trigger, cancel
+}
@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Debounce(t *testing.T) {
+	var calls atomic.Int32
+	trigger, cancel := Debounce(20*time.Millisecond, func() {
+		calls.Add(1)
+	})
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trigger()
+		}()
+	}
+	wg.Wait()
+
+	time.Sleep(100 * time.Millisecond)
+	testhelpers.AssertEqual(t, calls.Load(), int32(1))
+}
+
+func Test_Debounce_Cancel(t *testing.T) {
+	var calls atomic.Int32
+	trigger, cancel := Debounce(20*time.Millisecond, func() {
+		calls.Add(1)
+	})
+
+	trigger()
+	cancel()
+	trigger()
+
+	time.Sleep(100 * time.Millisecond)
+	testhelpers.AssertEqual(t, calls.Load(), int32(0))
+}
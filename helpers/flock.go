@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -19,16 +20,31 @@ type Flock struct {
 	flock        *goflock.Flock
 }
 
+// FlockOption is an option for NewFlock
+type FlockOption func(*Flock)
+
+// WithRetryBackoff sets the time elapsed between consecutive file locking attempts
+func WithRetryBackoff(d time.Duration) FlockOption {
+	return func(f *Flock) {
+		f.retryBackoff = d
+	}
+}
+
 // Original implementation was taken from URL: https://github.com/etcd-io/bbolt/blob/master/bolt_unix.go
 
 // NewFlock creates a new Flock instance for the specified file path.
-// It initializes the Flock with a default retry backoff of 64 milliseconds
-func NewFlock(path string) *Flock {
-	return &Flock{
+// It initializes the Flock with a default retry backoff of 64 milliseconds,
+// unless overridden using WithRetryBackoff()
+func NewFlock(path string, opts ...FlockOption) *Flock {
+	f := &Flock{
 		path:         path,
 		retryBackoff: 64 * time.Millisecond,
 		flock:        goflock.New(path),
 	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
 }
 
 // Lock attempts to acquire a lock on the file, either exclusive or shared.
@@ -39,20 +55,12 @@ func (f *Flock) Lock(exclusive bool, timeout time.Duration) error {
 		now    = time.Now()
 	)
 	for {
-		var (
-			ok  bool
-			err error
-		)
-		if exclusive {
-			ok, err = f.flock.TryLock()
-		} else {
-			ok, err = f.flock.TryRLock()
-		}
+		ok, err := f.tryLock(exclusive)
 		if ok {
 			return nil
 		}
 		if err != nil {
-			return fmt.Errorf("unable to lock the path %q: %w", f.path, err)
+			return err
 		}
 
 		if timeout > 0 && time.Since(now) > expiry {
@@ -62,6 +70,87 @@ func (f *Flock) Lock(exclusive bool, timeout time.Duration) error {
 	}
 }
 
+// LockContext attempts to acquire a lock on the file, either exclusive or shared.
+// It retries until the lock is acquired or the context is done, in which case the context error is returned
+func (f *Flock) LockContext(ctx context.Context, exclusive bool) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		ok, err := f.tryLock(exclusive)
+		if ok {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		timer.Reset(f.retryBackoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (f *Flock) tryLock(exclusive bool) (bool, error) {
+	var (
+		ok  bool
+		err error
+	)
+	if exclusive {
+		ok, err = f.flock.TryLock()
+	} else {
+		ok, err = f.flock.TryRLock()
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to lock the path %q: %w", f.path, err)
+	}
+	return ok, nil
+}
+
+// Upgrade converts an acquired shared lock into an exclusive lock.
+// It retries until the exclusive lock is acquired or the timeout is reached,
+// in which case an error is returned and no lock is held.
+//
+// NOTE: Converting an advisory lock is not atomic, as the shared lock is released
+// before the exclusive lock is obtained. Therefore another process waiting for the exclusive lock
+// may acquire it in-between, so any state read whilst holding the shared lock should be re-validated
+func (f *Flock) Upgrade(timeout time.Duration) error {
+	if !f.flock.RLocked() || f.flock.Locked() {
+		return fmt.Errorf("unable to upgrade the lock of the path %q: shared lock is not held", f.path)
+	}
+	if err := f.Lock(true, timeout); err != nil {
+		// A failed conversion has already released the shared lock, so ensure the state reflects that
+		_ = f.flock.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Downgrade converts an acquired exclusive lock into a shared lock.
+//
+// NOTE: The exclusive lock is released before the shared lock is obtained, therefore another
+// process may acquire the exclusive lock in-between. If that occurs an error is returned and no lock is held
+func (f *Flock) Downgrade() error {
+	if !f.flock.Locked() {
+		return fmt.Errorf("unable to downgrade the lock of the path %q: exclusive lock is not held", f.path)
+	}
+	if err := f.Unlock(); err != nil {
+		return err
+	}
+
+	ok, err := f.tryLock(false)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("unable to downgrade the lock of the path %q: exclusive lock was acquired by another process", f.path)
+	}
+	return nil
+}
+
 // Unlock releases the acquired lock on the file
 func (f *Flock) Unlock() error {
 	if err := f.flock.Unlock(); err != nil {
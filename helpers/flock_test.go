@@ -0,0 +1,58 @@
+package helpers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Flock_LockContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.lock")
+
+	f1 := NewFlock(path)
+	testhelpers.AssertNoError(t, f1.LockContext(context.Background(), true))
+
+	f2 := NewFlock(path, WithRetryBackoff(time.Millisecond))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	testhelpers.AssertEqual(t, f2.LockContext(ctx, true), context.DeadlineExceeded)
+
+	testhelpers.AssertNoError(t, f1.Unlock())
+	testhelpers.AssertNoError(t, f2.LockContext(context.Background(), true))
+	testhelpers.AssertNoError(t, f2.Unlock())
+}
+
+func Test_Flock_UpgradeAndDowngrade(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.lock")
+
+	f1 := NewFlock(path, WithRetryBackoff(time.Millisecond))
+	f2 := NewFlock(path, WithRetryBackoff(time.Millisecond))
+
+	testhelpers.AssertError(t, f1.Upgrade(10*time.Millisecond))
+	testhelpers.AssertError(t, f1.Downgrade())
+
+	// Shared
+	testhelpers.AssertNoError(t, f1.Lock(false, 10*time.Millisecond))
+	testhelpers.AssertNoError(t, f2.Lock(false, 10*time.Millisecond))
+
+	// Unable to upgrade whilst another shared lock is held, which releases the shared lock
+	testhelpers.AssertEqual(t, f1.Upgrade(10*time.Millisecond), ErrFlockTimeout)
+	testhelpers.AssertError(t, f1.Upgrade(10*time.Millisecond))
+	testhelpers.AssertNoError(t, f2.Unlock())
+	testhelpers.AssertNoError(t, f1.Lock(false, 10*time.Millisecond))
+
+	// Shared -> exclusive
+	testhelpers.AssertNoError(t, f1.Upgrade(10*time.Millisecond))
+	testhelpers.AssertEqual(t, f2.Lock(false, 10*time.Millisecond), ErrFlockTimeout)
+
+	// Exclusive -> shared
+	testhelpers.AssertNoError(t, f1.Downgrade())
+	testhelpers.AssertEqual(t, f2.Lock(true, 10*time.Millisecond), ErrFlockTimeout)
+	testhelpers.AssertNoError(t, f2.Lock(false, 10*time.Millisecond))
+
+	testhelpers.AssertNoError(t, f1.Unlock())
+	testhelpers.AssertNoError(t, f2.Unlock())
+}
@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Humanize returns a coarse relative description of the time, compared to the reference time
+// e.g. "just now", "5 minutes ago", "2 hours ago", "yesterday", "3 days ago" or "in 4 hours".
+// Differences of a day or more are based on calendar days in the time zone of the reference time
+//
+// Example usage:
+//
+//	now := time.Now()
+//	s := Humanize(now.Add(-5*time.Minute), now) // Returns "5 minutes ago"
+func Humanize(t, ref time.Time) string {
+	diff := ref.Sub(t)
+	future := diff < 0
+	if future {
+		diff = -diff
+	}
+
+	switch {
+	case diff < time.Minute:
+		return "just now"
+	case diff < time.Hour:
+		return humanizeRelative(int(diff/time.Minute), "minute", future)
+	case diff < 24*time.Hour:
+		return humanizeRelative(int(diff/time.Hour), "hour", future)
+	}
+
+	// Round to the nearest day, as days are not always 24 hours during daylight saving time transitions
+	days := int(math.Round(StartOfDay(ref).Sub(StartOfDay(t.In(ref.Location()))).Hours() / 24))
+	if future {
+		days = -days
+	}
+	days = max(days, 1)
+	if days == 1 {
+		if future {
+			return "tomorrow"
+		}
+		return "yesterday"
+	}
+	return humanizeRelative(days, "day", future)
+}
+
+func humanizeRelative(n int, unit string, future bool) string {
+	s := strconv.Itoa(n) + " " + unit
+	if n != 1 {
+		s += "s"
+	}
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
@@ -0,0 +1,94 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Humanize(t *testing.T) {
+	ref := time.Date(2024, 12, 20, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{
+			name: "same time",
+			t:    ref,
+			want: "just now",
+		},
+		{
+			name: "59 seconds ago",
+			t:    ref.Add(-59 * time.Second),
+			want: "just now",
+		},
+		{
+			name: "59 seconds in the future",
+			t:    ref.Add(59 * time.Second),
+			want: "just now",
+		},
+		{
+			name: "1 minute ago",
+			t:    ref.Add(-1 * time.Minute),
+			want: "1 minute ago",
+		},
+		{
+			name: "59 minutes ago",
+			t:    ref.Add(-59*time.Minute - 59*time.Second),
+			want: "59 minutes ago",
+		},
+		{
+			name: "1 hour ago",
+			t:    ref.Add(-1 * time.Hour),
+			want: "1 hour ago",
+		},
+		{
+			name: "23 hours ago",
+			t:    ref.Add(-23*time.Hour - 59*time.Minute),
+			want: "23 hours ago",
+		},
+		{
+			name: "yesterday",
+			t:    ref.Add(-24 * time.Hour),
+			want: "yesterday",
+		},
+		{
+			name: "2 days ago by calendar day",
+			t:    ref.Add(-47 * time.Hour),
+			want: "2 days ago",
+		},
+		{
+			name: "3 days ago",
+			t:    ref.Add(-3 * 24 * time.Hour),
+			want: "3 days ago",
+		},
+		{
+			name: "in 1 minute",
+			t:    ref.Add(1 * time.Minute),
+			want: "in 1 minute",
+		},
+		{
+			name: "in 4 hours",
+			t:    ref.Add(4 * time.Hour),
+			want: "in 4 hours",
+		},
+		{
+			name: "tomorrow",
+			t:    ref.Add(24 * time.Hour),
+			want: "tomorrow",
+		},
+		{
+			name: "in 3 days",
+			t:    ref.Add(3 * 24 * time.Hour),
+			want: "in 3 days",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Humanize(tt.t, ref)
+			testhelpers.AssertEqual(t, got, tt.want)
+		})
+	}
+}
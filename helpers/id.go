@@ -2,12 +2,46 @@ package helpers
 
 import (
 	"crypto/rand"
+	"encoding/base32"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"strings"
 	"time"
 )
 
+// IDEncoding is the encoding used for the generated identifier
+type IDEncoding int
+
+const (
+	// IDEncodingHex encodes the identifier as lowercase hexadecimal (default)
+	IDEncodingHex IDEncoding = iota
+
+	// IDEncodingBase32 encodes the identifier as unpadded standard base32
+	IDEncodingBase32
+
+	// IDEncodingBase62 encodes the identifier using the characters 0-9, a-z and A-Z,
+	// which is URL-friendly and the most compact of the encodings
+	IDEncodingBase62
+)
+
+// IDOption is an option for IDWithLength
+type IDOption func(*idOptions)
+
+type idOptions struct {
+	encoding IDEncoding
+}
+
+// WithIDEncoding sets the encoding used for the generated identifier
+func WithIDEncoding(enc IDEncoding) IDOption {
+	return func(o *idOptions) {
+		o.encoding = enc
+	}
+}
+
 // ID generates a unique identifier of type T, which must be a string type i.e. string alias types can be used.
 // The identifier is created by combining a random byte sequence with the current
 // Unix timestamp in milliseconds, ensuring that each ID is unique.
@@ -22,12 +56,60 @@ import (
 //	}
 //	fmt.Println("Generated ID:", id)
 func ID[T ~string]() (T, error) {
-	b := make([]byte, 32)
-	if _, err := rand.Read(b[:24]); err != nil {
+	return IDWithLength[T](24)
+}
+
+// IDWithLength generates a unique identifier of type T, which must be a string type i.e. string alias types can be used.
+// The identifier is created by combining the specified number of random bytes with the current
+// Unix timestamp in milliseconds (8 bytes), and is encoded as hexadecimal unless
+// a different encoding is provided using WithIDEncoding().
+//
+// Example usage:
+//
+//	id, err := helpers.IDWithLength[string](8, helpers.WithIDEncoding(helpers.IDEncodingBase62))
+//	if err != nil {
+//		log.Fatalf("Error generating ID: %v", err)
+//	}
+//	fmt.Println("Generated ID:", id)
+func IDWithLength[T ~string](randomBytes int, opts ...IDOption) (T, error) {
+	if randomBytes <= 0 {
+		return "", fmt.Errorf("creating ID: invalid random bytes length %d, expected greater than 0", randomBytes)
+	}
+
+	o := idOptions{
+		encoding: IDEncodingHex,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	b := make([]byte, randomBytes+8)
+	if _, err := rand.Read(b[:randomBytes]); err != nil {
 		return "", fmt.Errorf("creating ID: %w", err)
 	}
 
-	// 8 bytes used for the timestamp i.e. 32 - 8 = 24
-	binary.BigEndian.PutUint64(b[24:], uint64(time.Now().UnixMilli()))
-	return T(hex.EncodeToString(b)), nil
+	// 8 bytes used for the timestamp
+	binary.BigEndian.PutUint64(b[randomBytes:], uint64(time.Now().UnixMilli()))
+
+	switch o.encoding {
+	case IDEncodingHex:
+		return T(hex.EncodeToString(b)), nil
+	case IDEncodingBase32:
+		return T(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)), nil
+	case IDEncodingBase62:
+		return T(encodeBase62(b)), nil
+	default:
+		return "", errors.New("creating ID: invalid encoding")
+	}
+}
+
+func encodeBase62(b []byte) string {
+	s := new(big.Int).SetBytes(b).Text(62)
+
+	// Pad with leading zeros, so the length is the same regardless of the byte values
+	size := int(math.Ceil(float64(len(b)*8) / math.Log2(62)))
+	if len(s) < size {
+		s = strings.Repeat("0", size-len(s)) + s
+	}
+	return s
 }
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"regexp"
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_ID(t *testing.T) {
+	id, err := ID[string]()
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, len(id), 64)
+}
+
+func Test_IDWithLength(t *testing.T) {
+	tests := []struct {
+		name        string
+		randomBytes int
+		opts        []IDOption
+		wantPattern string
+		wantErr     bool
+	}{
+		{
+			name:        "hex encoding by default",
+			randomBytes: 8,
+			wantPattern: "^[0-9a-f]{32}$",
+		},
+		{
+			name:        "base32 encoding",
+			randomBytes: 8,
+			opts:        []IDOption{WithIDEncoding(IDEncodingBase32)},
+			wantPattern: "^[A-Z2-7]{26}$",
+		},
+		{
+			name:        "base62 encoding",
+			randomBytes: 8,
+			opts:        []IDOption{WithIDEncoding(IDEncodingBase62)},
+			wantPattern: "^[0-9a-zA-Z]{22}$",
+		},
+		{
+			name:        "invalid encoding",
+			randomBytes: 8,
+			opts:        []IDOption{WithIDEncoding(IDEncoding(-1))},
+			wantErr:     true,
+		},
+		{
+			name:        "zero random bytes",
+			randomBytes: 0,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IDWithLength[string](tt.randomBytes, tt.opts...)
+			testhelpers.AssertEqual(t, err != nil, tt.wantErr)
+			if !tt.wantErr {
+				testhelpers.AssertEqual(t, regexp.MustCompile(tt.wantPattern).MatchString(got), true)
+			}
+		})
+	}
+}
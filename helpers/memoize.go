@@ -0,0 +1,67 @@
+package helpers
+
+import (
+	"sync"
+	"time"
+)
+
+// Memoize returns a function, which caches the successful results of fn by key, so fn is only called
+// once per distinct key. Errors are not cached, so fn is called again for that key.
+// The returned function is safe to call concurrently, but as fn is called without holding the lock,
+// concurrent first calls with the same key may each call fn.
+//
+// Example usage:
+//
+//	getUser := Memoize(func(id int) (User, error) {
+//		return db.GetUser(id)
+//	})
+//	user, err := getUser(1) // Calls db.GetUser(1)
+//	user, err = getUser(1)  // Returns the cached result
+func Memoize[K comparable, V any](fn func(K) (V, error)) func(K) (V, error) {
+	return memoize(fn, 0)
+}
+
+// MemoizeWithTTL is the same as Memoize, but the cached results expire after the time-to-live,
+// in which fn is called again for that key.
+//
+// NOTE: Expired results are only removed when their key is requested again
+func MemoizeWithTTL[K comparable, V any](fn func(K) (V, error), ttl time.Duration) func(K) (V, error) {
+	return memoize(fn, ttl)
+}
+
+type memoizeEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func memoize[K comparable, V any](fn func(K) (V, error), ttl time.Duration) func(K) (V, error) {
+	var (
+		mu      sync.Mutex
+		entries = make(map[K]memoizeEntry[V])
+	)
+	return func(key K) (V, error) {
+		mu.Lock()
+		entry, ok := entries[key]
+		mu.Unlock()
+		if ok && (entry.expiresAt.IsZero() || time.Now().Before(entry.expiresAt)) {
+			return entry.value, nil
+		}
+
+		v, err := fn(key)
+		if err != nil {
+			return v, err
+		}
+
+		entry = memoizeEntry[V]{
+			value: v,
+		}
+		if ttl > 0 {
+			entry.expiresAt = time.Now().Add(ttl)
+		}
+
+		mu.Lock()
+		entries[key] = entry
+		mu.Unlock()
+		return v, nil
+	}
+}
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Memoize(t *testing.T) {
+	callsByKey := map[int]int{}
+	fn := Memoize(func(key int) (string, error) {
+		callsByKey[key]++
+		if key < 0 {
+			return "", errors.New("negative key")
+		}
+		return strconv.Itoa(key), nil
+	})
+
+	for range 3 {
+		for _, key := range []int{1, 2} {
+			v, err := fn(key)
+			testhelpers.AssertNoError(t, err)
+			testhelpers.AssertEqual(t, v, strconv.Itoa(key))
+		}
+
+		_, err := fn(-1)
+		testhelpers.AssertError(t, err)
+	}
+
+	// Errors are not cached
+	testhelpers.AssertEqual(t, callsByKey, map[int]int{1: 1, 2: 1, -1: 3})
+}
+
+func Test_MemoizeWithTTL(t *testing.T) {
+	calls := 0
+	fn := MemoizeWithTTL(func(key int) (int, error) {
+		calls++
+		return key * 2, nil
+	}, 20*time.Millisecond)
+
+	for range 3 {
+		v, err := fn(1)
+		testhelpers.AssertNoError(t, err)
+		testhelpers.AssertEqual(t, v, 2)
+	}
+	testhelpers.AssertEqual(t, calls, 1)
+
+	time.Sleep(40 * time.Millisecond)
+	_, err := fn(1)
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, calls, 2)
+}
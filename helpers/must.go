@@ -17,3 +17,29 @@ func Must[T any](res T, err error) T {
 	}
 	return res
 }
+
+// Must0 panics with the provided error if it is not nil.
+// It is the same as Must(), but for functions which only return an error
+func Must0(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Must2 returns the results if there is no error; otherwise, it panics with the provided error.
+// It is the same as Must(), but for functions which return two results and an error
+func Must2[A, B any](a A, b B, err error) (A, B) {
+	if err != nil {
+		panic(err)
+	}
+	return a, b
+}
+
+// Must3 returns the results if there is no error; otherwise, it panics with the provided error.
+// It is the same as Must(), but for functions which return three results and an error
+func Must3[A, B, C any](a A, b B, c C, err error) (A, B, C) {
+	if err != nil {
+		panic(err)
+	}
+	return a, b, c
+}
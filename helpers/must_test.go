@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Must(t *testing.T) {
+	testhelpers.AssertEqual(t, Must(1, nil), 1)
+	assertPanics(t, func() {
+		Must(1, errors.New("unexpected error"))
+	})
+}
+
+func Test_Must0(t *testing.T) {
+	Must0(nil)
+	assertPanics(t, func() {
+		Must0(errors.New("unexpected error"))
+	})
+}
+
+func Test_Must2(t *testing.T) {
+	a, b := Must2(1, "b", nil)
+	testhelpers.AssertEqual(t, a, 1)
+	testhelpers.AssertEqual(t, b, "b")
+	assertPanics(t, func() {
+		Must2(1, "b", errors.New("unexpected error"))
+	})
+}
+
+func Test_Must3(t *testing.T) {
+	a, b, c := Must3(1, "b", true, nil)
+	testhelpers.AssertEqual(t, a, 1)
+	testhelpers.AssertEqual(t, b, "b")
+	testhelpers.AssertEqual(t, c, true)
+	assertPanics(t, func() {
+		Must3(1, "b", true, errors.New("unexpected error"))
+	})
+}
+
+func assertPanics(t *testing.T, fn func()) {
+	t.Helper()
+	defer func() {
+		testhelpers.AssertEqual(t, recover() != nil, true)
+	}()
+	fn()
+}
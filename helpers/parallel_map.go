@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ParallelMap calls fn for each item using the specified number of worker go routines,
+// returning the results in the same order as the items.
+// If fn returns an error, then the context passed to fn is cancelled, the remaining items are skipped
+// and the first error is returned.
+// An error is returned if the number of workers is less than or equal to 0
+//
+// Example usage:
+//
+//	bodies, err := ParallelMap(ctx, urls, 4, func(ctx context.Context, url string) ([]byte, error) {
+//		return fetch(ctx, url)
+//	})
+func ParallelMap[E, R any](ctx context.Context, items []E, workers int, fn func(context.Context, E) (R, error)) ([]R, error) {
+	if workers <= 0 {
+		return nil, fmt.Errorf("invalid number of workers %d, expected greater than 0", workers)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		res     = make([]R, len(items))
+		idxCh   = make(chan int)
+		wg      sync.WaitGroup
+		errOnce sync.Once
+		err     error
+	)
+	for range min(workers, len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				v, fnErr := fn(ctx, items[idx])
+				if fnErr != nil {
+					errOnce.Do(func() {
+						err = fnErr
+						cancel()
+					})
+					continue
+				}
+				res[idx] = v
+			}
+		}()
+	}
+
+	for idx := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case idxCh <- idx:
+		}
+	}
+	close(idxCh)
+	wg.Wait()
+
+	if err != nil {
+		return nil, err
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		// The parent context was done, as the context is only cancelled on error otherwise
+		return nil, ctxErr
+	}
+	return res, nil
+}
@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_ParallelMap(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3}
+	got, err := ParallelMap(context.Background(), items, 3, func(ctx context.Context, item int) (int, error) {
+		// Finish in a different order than the items
+		time.Sleep(time.Duration(item) * time.Millisecond)
+		return item * 10, nil
+	})
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, got, []int{50, 10, 40, 20, 30})
+}
+
+func Test_ParallelMap_Error(t *testing.T) {
+	var (
+		errItem = errors.New("item error")
+		calls   atomic.Int32
+		items   = make([]int, 100)
+	)
+	for i := range items {
+		items[i] = i
+	}
+
+	got, err := ParallelMap(context.Background(), items, 2, func(ctx context.Context, item int) (int, error) {
+		calls.Add(1)
+		if item == 3 {
+			return 0, errItem
+		}
+		time.Sleep(time.Millisecond)
+		return item, nil
+	})
+	testhelpers.AssertEqual(t, err, errItem)
+	testhelpers.AssertEqual(t, got, []int(nil))
+	testhelpers.AssertEqual(t, calls.Load() < int32(len(items)), true)
+}
+
+func Test_ParallelMap_InvalidWorkers(t *testing.T) {
+	_, err := ParallelMap(context.Background(), []int{1}, 0, func(ctx context.Context, item int) (int, error) {
+		return item, nil
+	})
+	testhelpers.AssertError(t, err)
+}
@@ -11,3 +11,17 @@ func FromPtr[T any](v *T) T {
 	}
 	return *v
 }
+
+// Deref returns the value the pointer points to, or the provided default value
+// if the pointer is nil.
+//
+// Example usage:
+//
+//	var name *string
+//	v := Deref(name, "unknown") // Returns "unknown"
+func Deref[T any](v *T, def T) T {
+	if v == nil {
+		return def
+	}
+	return *v
+}
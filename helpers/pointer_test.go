@@ -0,0 +1,23 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_ToPtr(t *testing.T) {
+	p := ToPtr(10)
+	testhelpers.AssertEqual(t, *p, 10)
+}
+
+func Test_FromPtr(t *testing.T) {
+	testhelpers.AssertEqual(t, FromPtr(ToPtr("value")), "value")
+	testhelpers.AssertEqual(t, FromPtr[string](nil), "")
+}
+
+func Test_Deref(t *testing.T) {
+	testhelpers.AssertEqual(t, Deref(ToPtr(10), 20), 10)
+	testhelpers.AssertEqual(t, Deref(ToPtr(0), 20), 0)
+	testhelpers.AssertEqual(t, Deref(nil, 20), 20)
+}
@@ -3,6 +3,7 @@ package helpers
 import (
 	"errors"
 	"fmt"
+	"runtime/debug"
 )
 
 // RecoverFunc is a helper function that recovers from panics in a given function.
@@ -21,16 +22,34 @@ import (
 //	})
 func RecoverFunc(fn func(err error)) {
 	if r := recover(); r != nil {
-		var err error
-		switch e := r.(type) {
-		case error:
-			err = fmt.Errorf("recovered panic: %w", e)
-		default:
-			err = fmt.Errorf("%v", e)
-		}
-		if err == nil {
-			err = errors.New("unexpected nil error")
-		}
-		fn(err)
+		fn(toRecoveredError(r))
 	}
 }
+
+// RecoverFuncWithStack is the same as RecoverFunc, but additionally passes the stack trace,
+// which is captured at the time of recovery and includes where the panic occurred.
+//
+// Example usage:
+//
+//	defer RecoverFuncWithStack(func(err error, stack []byte) {
+//		fmt.Printf("Recovered from panic: %v\n%s", err, stack)
+//	})
+func RecoverFuncWithStack(fn func(err error, stack []byte)) {
+	if r := recover(); r != nil {
+		fn(toRecoveredError(r), debug.Stack())
+	}
+}
+
+func toRecoveredError(r any) error {
+	var err error
+	switch e := r.(type) {
+	case error:
+		err = fmt.Errorf("recovered panic: %w", e)
+	default:
+		err = fmt.Errorf("%v", e)
+	}
+	if err == nil {
+		err = errors.New("unexpected nil error")
+	}
+	return err
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
@@ -18,3 +19,20 @@ func Test_RecoverFunc(t *testing.T) {
 	}()
 	testhelpers.AssertEqual(t, errors.Unwrap(errRecovered) == errUnexepected, true)
 }
+
+func Test_RecoverFuncWithStack(t *testing.T) {
+	var (
+		errRecovered error
+		stack        []byte
+	)
+	errUnexepected := errors.New("unexpected panic")
+	func() {
+		defer RecoverFuncWithStack(func(err error, s []byte) {
+			errRecovered = err
+			stack = s
+		})
+		panic(errUnexepected)
+	}()
+	testhelpers.AssertEqual(t, errors.Unwrap(errRecovered) == errUnexepected, true)
+	testhelpers.AssertEqual(t, strings.Contains(string(stack), "Test_RecoverFuncWithStack"), true)
+}
@@ -1,6 +1,10 @@
 package helpers
 
-import "time"
+import (
+	"time"
+
+	"github.com/softwarespot/go-helpers/errors"
+)
 
 // Taken from URL: https://github.com/matryer/try/blob/master/try.go
 
@@ -8,16 +12,28 @@ import "time"
 // or the maximum number of retries has exceeded. The last function error
 // is returned, if the maximum number of retries is exceeded
 func Retry(fn func(iter int) error, retries int, retriesWait time.Duration) error {
+	return RetryIf(fn, retries, retriesWait, func(error) bool {
+		return true
+	})
+}
+
+// RetryIf is the same as Retry, but only retries when the function error satisfies the predicate,
+// otherwise the error is returned immediately.
+// If the predicate is nil, then only errors marked as retryable using errors.MarkRetryable() are retried
+func RetryIf(fn func(iter int) error, retries int, retriesWait time.Duration, shouldRetry func(err error) bool) error {
 	if retries <= 0 {
 		retries = 1
 	}
+	if shouldRetry == nil {
+		shouldRetry = errors.IsRetryable
+	}
 
 	for iter := 1; ; iter++ {
 		err := fn(iter)
 		if err == nil {
 			return nil
 		}
-		if iter >= retries {
+		if iter >= retries || !shouldRetry(err) {
 			return err
 		}
 		time.Sleep(retriesWait)
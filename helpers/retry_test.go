@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	goerrors "github.com/softwarespot/go-helpers/errors"
 	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
 )
 
@@ -68,3 +69,48 @@ func Test_Retry(t *testing.T) {
 		})
 	}
 }
+
+func Test_RetryIf(t *testing.T) {
+	errTransient := errors.New("transient error")
+	tests := []struct {
+		name        string
+		err         error
+		shouldRetry func(err error) bool
+		wantIter    int
+	}{
+		{
+			name:     "retryable error is retried by default",
+			err:      goerrors.MarkRetryable(errTransient),
+			wantIter: 3,
+		},
+		{
+			name:     "wrapped retryable error is retried by default",
+			err:      goerrors.Wrap(goerrors.MarkRetryable(errTransient), "wrapped"),
+			wantIter: 3,
+		},
+		{
+			name:     "non-retryable error is not retried by default",
+			err:      errTransient,
+			wantIter: 1,
+		},
+		{
+			name: "custom predicate",
+			err:  errTransient,
+			shouldRetry: func(err error) bool {
+				return errors.Is(err, errTransient)
+			},
+			wantIter: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iter := 0
+			err := RetryIf(func(i int) error {
+				iter = i
+				return tt.err
+			}, 3, 1*time.Microsecond, tt.shouldRetry)
+			testhelpers.AssertEqual(t, err, tt.err)
+			testhelpers.AssertEqual(t, iter, tt.wantIter)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package helpers
+
+// SliceFindFunc returns the first element which satisfies the predicate, along with its index.
+// If no element satisfies the predicate, then the zero value, -1 and false are returned
+//
+// Example usage:
+//
+//	users := []User{{ID: 1, Name: "Jane"}, {ID: 2, Name: "John"}}
+//	user, idx, ok := SliceFindFunc(users, func(u User) bool {
+//		return u.ID == 2
+//	}) // Returns {2 John}, 1, true
+func SliceFindFunc[S ~[]E, E any](s S, pred func(E) bool) (E, int, bool) {
+	for i, v := range s {
+		if pred(v) {
+			return v, i, true
+		}
+	}
+
+	var v E
+	return v, -1, false
+}
+
+// SliceContainsFunc reports whether at least one element satisfies the predicate
+func SliceContainsFunc[S ~[]E, E any](s S, pred func(E) bool) bool {
+	_, _, ok := SliceFindFunc(s, pred)
+	return ok
+}
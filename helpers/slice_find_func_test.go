@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_SliceFindFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{
+		{ID: 1, Name: "Jane"},
+		{ID: 2, Name: "John"},
+		{ID: 3, Name: "John"},
+	}
+
+	tests := []struct {
+		name    string
+		pred    func(user) bool
+		want    user
+		wantIdx int
+		wantOk  bool
+	}{
+		{
+			name: "found by field",
+			pred: func(u user) bool {
+				return u.Name == "John"
+			},
+			want:    user{ID: 2, Name: "John"},
+			wantIdx: 1,
+			wantOk:  true,
+		},
+		{
+			name: "not found",
+			pred: func(u user) bool {
+				return u.ID == 4
+			},
+			want:    user{},
+			wantIdx: -1,
+			wantOk:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, idx, ok := SliceFindFunc(users, tt.pred)
+			testhelpers.AssertEqual(t, got, tt.want)
+			testhelpers.AssertEqual(t, idx, tt.wantIdx)
+			testhelpers.AssertEqual(t, ok, tt.wantOk)
+			testhelpers.AssertEqual(t, SliceContainsFunc(users, tt.pred), tt.wantOk)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package helpers
+
+// SliceFlatten returns a new slice, which concatenates the provided sub-slices in order
+//
+// Example usage:
+//
+//	flattened := SliceFlatten([][]int{{1, 2}, {3}, {}}) // Returns []int{1, 2, 3}
+func SliceFlatten[S ~[]E, E any](ss []S) S {
+	size := 0
+	for _, s := range ss {
+		size += len(s)
+	}
+
+	res := make(S, 0, size)
+	for _, s := range ss {
+		res = append(res, s...)
+	}
+	return res
+}
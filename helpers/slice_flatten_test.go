@@ -0,0 +1,13 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_SliceFlatten(t *testing.T) {
+	testhelpers.AssertEqual(t, SliceFlatten([][]int{{1, 2}, {3}, {}, {4, 5, 6}}), []int{1, 2, 3, 4, 5, 6})
+	testhelpers.AssertEqual(t, SliceFlatten([][]int{{}, nil}), []int{})
+	testhelpers.AssertEqual(t, SliceFlatten[[]int](nil), []int{})
+}
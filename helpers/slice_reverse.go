@@ -0,0 +1,19 @@
+package helpers
+
+// SliceReverse returns a new slice with the elements of the provided slice in reverse order.
+// The provided slice is not modified
+//
+// Example usage:
+//
+//	reversed := SliceReverse([]int{1, 2, 3}) // Returns []int{3, 2, 1}
+func SliceReverse[S ~[]E, E any](s S) S {
+	if s == nil {
+		return nil
+	}
+
+	res := make(S, len(s))
+	for i, v := range s {
+		res[len(s)-1-i] = v
+	}
+	return res
+}
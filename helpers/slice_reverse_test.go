@@ -0,0 +1,15 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_SliceReverse(t *testing.T) {
+	s := []int{1, 2, 3}
+	testhelpers.AssertEqual(t, SliceReverse(s), []int{3, 2, 1})
+	testhelpers.AssertEqual(t, s, []int{1, 2, 3})
+	testhelpers.AssertEqual(t, SliceReverse([]int{}), []int{})
+	testhelpers.AssertEqual(t, SliceReverse([]int(nil)), []int(nil))
+}
@@ -0,0 +1,27 @@
+package helpers
+
+import "fmt"
+
+// SliceWindow returns the overlapping windows of the specified size, sliding by one element.
+// The windows share the same underlying array as the provided slice, but their capacity is limited
+// to the size, so appending to a window doesn't modify the provided slice.
+// If the size is larger than the length of the slice, then no windows are returned.
+// An error is returned if the size is less than or equal to 0
+//
+// Example usage:
+//
+//	windows, err := SliceWindow([]int{1, 2, 3, 4}, 2) // Returns [][]int{{1, 2}, {2, 3}, {3, 4}}
+func SliceWindow[S ~[]E, E any](s S, size int) ([]S, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid window size %d, expected greater than 0", size)
+	}
+	if size > len(s) {
+		return nil, nil
+	}
+
+	res := make([]S, 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		res = append(res, s[i:i+size:i+size])
+	}
+	return res, nil
+}
@@ -0,0 +1,50 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_SliceWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       []int
+		size    int
+		want    [][]int
+		wantErr bool
+	}{
+		{
+			name: "windows of 2",
+			s:    []int{1, 2, 3, 4},
+			size: 2,
+			want: [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name: "window equal to the length",
+			s:    []int{1, 2, 3},
+			size: 3,
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "window larger than the length",
+			s:    []int{1, 2},
+			size: 3,
+			want: nil,
+		},
+		{
+			name:    "invalid size",
+			s:       []int{1, 2},
+			size:    0,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SliceWindow(tt.s, tt.size)
+			testhelpers.AssertEqual(t, err != nil, tt.wantErr)
+			testhelpers.AssertEqual(t, got, tt.want)
+		})
+	}
+}
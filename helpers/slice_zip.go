@@ -0,0 +1,22 @@
+package helpers
+
+// SliceZip returns a new slice, which pairs the elements of both slices at the same index.
+// The length of the result is the length of the shorter slice
+//
+// Example usage:
+//
+//	zipped := SliceZip([]int{1, 2, 3}, []string{"a", "b"}) // Returns [{1 a} {2 b}]
+func SliceZip[A, B any](as []A, bs []B) []struct {
+	First  A
+	Second B
+} {
+	res := make([]struct {
+		First  A
+		Second B
+	}, min(len(as), len(bs)))
+	for i := range res {
+		res[i].First = as[i]
+		res[i].Second = bs[i]
+	}
+	return res
+}
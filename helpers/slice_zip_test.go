@@ -0,0 +1,19 @@
+package helpers
+
+import (
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_SliceZip(t *testing.T) {
+	type pair = struct {
+		First  int
+		Second string
+	}
+
+	testhelpers.AssertEqual(t, SliceZip([]int{1, 2, 3}, []string{"a", "b"}), []pair{{1, "a"}, {2, "b"}})
+	testhelpers.AssertEqual(t, SliceZip([]int{1}, []string{"a", "b"}), []pair{{1, "a"}})
+	testhelpers.AssertEqual(t, SliceZip([]int{}, []string{"a", "b"}), []pair{})
+	testhelpers.AssertEqual(t, SliceZip[int, string](nil, nil), []pair{})
+}
@@ -0,0 +1,57 @@
+package helpers
+
+import (
+	"sync"
+	"time"
+)
+
+// Throttle returns a trigger function, which calls fn at most once per duration.
+// The first call to trigger invokes fn immediately, after which any calls are ignored until
+// the duration has elapsed. If trigger was called during that window, then fn is invoked
+// once more at the end of the window (a trailing call).
+// The trigger function is safe to call concurrently
+//
+// Example usage:
+//
+//	trigger := Throttle(time.Second, func() {
+//		fmt.Println("saving")
+//	})
+//
+//	trigger() // Prints "saving" immediately
+//	trigger() // Ignored, but causes "saving" to be printed once more after 1s
+//	trigger() // Ignored
+func Throttle(d time.Duration, fn func()) (trigger func()) {
+	var (
+		mu        sync.Mutex
+		throttled bool
+		pending   bool
+		release   func()
+	)
+	release = func() {
+		mu.Lock()
+		if !pending {
+			throttled = false
+			mu.Unlock()
+			return
+		}
+		pending = false
+		mu.Unlock()
+
+		time.AfterFunc(d, release)
+		fn()
+	}
+	trigger = func() {
+		mu.Lock()
+		if throttled {
+			pending = true
+			mu.Unlock()
+			return
+		}
+		throttled = true
+		mu.Unlock()
+
+		time.AfterFunc(d, release)
+		fn()
+	}
+	return trigger
+}
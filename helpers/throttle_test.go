@@ -0,0 +1,38 @@
+package helpers
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Throttle(t *testing.T) {
+	var calls atomic.Int32
+	trigger := Throttle(50*time.Millisecond, func() {
+		calls.Add(1)
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trigger()
+		}()
+	}
+	wg.Wait()
+
+	// Only the leading call should have been invoked
+	testhelpers.AssertEqual(t, calls.Load(), int32(1))
+
+	// The trailing call should be invoked after the window has elapsed
+	time.Sleep(200 * time.Millisecond)
+	testhelpers.AssertEqual(t, calls.Load(), int32(2))
+
+	// A new window starts once the previous one has elapsed without calls
+	trigger()
+	testhelpers.AssertEqual(t, calls.Load(), int32(3))
+}
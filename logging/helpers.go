@@ -3,15 +3,14 @@ package logging
 import (
 	"os"
 	"runtime/debug"
+	"slices"
 	"time"
 )
 
 func createEntry(msg string, level Level, args ...any) map[string]any {
 	entry := map[string]any{}
 
-	if len(args) > 0 && len(args)%2 != 0 {
-		args = append(args, "%ARGS NOT DIVISIBLE BY 2%")
-	}
+	args = padArgs(args)
 	for i := 0; i < len(args); i += 2 {
 		key, ok := args[i].(string)
 		if !ok {
@@ -31,3 +30,12 @@ func createEntry(msg string, level Level, args ...any) map[string]any {
 	}
 	return entry
 }
+
+// padArgs pads the arguments with a placeholder value when not divisible by 2, so a segment of arguments
+// joined with other segments doesn't shift the keys and values which follow it
+func padArgs(args []any) []any {
+	if len(args)%2 == 0 {
+		return args
+	}
+	return slices.Concat(args, []any{"%ARGS NOT DIVISIBLE BY 2%"})
+}
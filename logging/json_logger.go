@@ -0,0 +1,90 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/softwarespot/go-helpers/errors"
+)
+
+// Ensure interface compatibility
+var _ Logger = &JSONLogger{}
+
+// JSONLogger is a logger that writes log entries to a writer in JSON format, one entry per line.
+// Log entries with a level below the minimum level are dropped
+type JSONLogger struct {
+	enc  *json.Encoder
+	mu   *sync.Mutex
+	args []any
+
+	// The severity of the minimum level, which is shared with child loggers
+	minSeverity *atomic.Int32
+}
+
+// NewJSONLogger creates a new instance of JSONLogger, which writes to the writer
+// and drops log entries below the minimum level
+func NewJSONLogger(w io.Writer, minLevel Level) *JSONLogger {
+	jl := &JSONLogger{
+		enc:         json.NewEncoder(w),
+		mu:          &sync.Mutex{},
+		args:        nil,
+		minSeverity: &atomic.Int32{},
+	}
+	jl.SetLevel(minLevel)
+	return jl
+}
+
+// SetLevel sets the minimum level, in which log entries below it are dropped.
+// This also applies to child loggers created using With(), as they share the minimum level
+func (jl *JSONLogger) SetLevel(minLevel Level) {
+	jl.minSeverity.Store(int32(minLevel.severity()))
+}
+
+// Fatal logs a critical error message and exits the application with the specified exit code
+func (jl *JSONLogger) Fatal(err error, code int, args ...any) {
+	jl.LogError(err, LevelCritical, args...)
+	os.Exit(code)
+}
+
+// LogError logs an error message with the specified log level.
+// The arguments of the error chain (see errors.Args()) are included before the optional additional arguments
+func (jl *JSONLogger) LogError(err error, level Level, args ...any) {
+	if !jl.isEnabled(level) {
+		return
+	}
+	jl.Log(err.Error(), level, slices.Concat(padArgs(errors.Args(err)), args)...)
+}
+
+// Log writes a log entry with a message, log level, and optional additional arguments, which must be divisible by 2
+func (jl *JSONLogger) Log(msg string, level Level, args ...any) {
+	if !jl.isEnabled(level) {
+		return
+	}
+
+	jl.mu.Lock()
+	defer jl.mu.Unlock()
+
+	if err := jl.enc.Encode(createEntry(msg, level, slices.Concat(jl.args, padArgs(args))...)); err != nil {
+		fmt.Fprintf(os.Stderr, "skipped logging entry due to error: %+v. Message: '%s', Level: %v\n", err, msg, level)
+	}
+}
+
+// With returns a child logger, which includes the arguments (which must be divisible by 2) in every log entry.
+// The child logger shares the same output and minimum level as the parent logger
+func (jl *JSONLogger) With(args ...any) Logger {
+	return &JSONLogger{
+		enc:         jl.enc,
+		mu:          jl.mu,
+		args:        slices.Concat(jl.args, padArgs(args)),
+		minSeverity: jl.minSeverity,
+	}
+}
+
+func (jl *JSONLogger) isEnabled(level Level) bool {
+	return int32(level.severity()) >= jl.minSeverity.Load()
+}
@@ -0,0 +1,87 @@
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/errors"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_JSONLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLogger(&buf, LevelWarning)
+
+	logger.Log("dropped", LevelNotice)
+	logger.Log("warning", LevelWarning, "key", "value")
+	logger.With("request-id", "1234").LogError(errors.New("error", "user-id", "5678"), LevelError)
+
+	entries := readEntries(t, &buf)
+	testhelpers.AssertEqual(t, len(entries), 2)
+
+	testhelpers.AssertEqual(t, entries[0]["@message"], any("warning"))
+	testhelpers.AssertEqual(t, entries[0]["@level"], any("warning"))
+	testhelpers.AssertEqual(t, entries[0]["key"], any("value"))
+
+	testhelpers.AssertEqual(t, entries[1]["@message"], any("error"))
+	testhelpers.AssertEqual(t, entries[1]["@level"], any("error"))
+	testhelpers.AssertEqual(t, entries[1]["request-id"], any("1234"))
+	testhelpers.AssertEqual(t, entries[1]["user-id"], any("5678"))
+}
+
+func Test_JSONLogger_OddArgs(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLogger(&buf, LevelNotice)
+
+	logger.With("request-id").LogError(errors.New("not found", "id"), LevelError, "status-code", 404)
+
+	entries := readEntries(t, &buf)
+	testhelpers.AssertEqual(t, len(entries), 1)
+	testhelpers.AssertEqual(t, entries[0]["@message"], any("not found"))
+	testhelpers.AssertEqual(t, entries[0]["request-id"], any("%ARGS NOT DIVISIBLE BY 2%"))
+	testhelpers.AssertEqual(t, entries[0]["id"], any("%ARGS NOT DIVISIBLE BY 2%"))
+	testhelpers.AssertEqual(t, entries[0]["status-code"], any(float64(404)))
+}
+
+func readEntries(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var entries []map[string]any
+	scanner := bufio.NewScanner(buf)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var entry map[string]any
+		testhelpers.AssertNoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	testhelpers.AssertNoError(t, scanner.Err())
+	return entries
+}
+
+func Test_JSONLogger_SetLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLogger(&buf, LevelNotice)
+	child := logger.With("child", true)
+
+	logger.SetLevel(LevelError)
+	logger.Log("suppressed", LevelNotice)
+	logger.Log("suppressed", LevelWarning)
+	child.Log("suppressed", LevelWarning)
+	logger.Log("emitted", LevelError)
+	child.Log("emitted", LevelCritical)
+
+	logger.SetLevel(LevelNotice)
+	logger.Log("emitted", LevelNotice)
+
+	entries := readEntries(t, &buf)
+	testhelpers.AssertEqual(t, len(entries), 3)
+	testhelpers.AssertEqual(t, entries[0]["@level"], any("error"))
+	testhelpers.AssertEqual(t, entries[1]["@level"], any("critical"))
+	testhelpers.AssertEqual(t, entries[1]["child"], any(true))
+	testhelpers.AssertEqual(t, entries[2]["@level"], any("notice"))
+	for _, entry := range entries {
+		testhelpers.AssertEqual(t, entry["@message"], any("emitted"))
+	}
+}
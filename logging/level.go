@@ -1,5 +1,7 @@
 package logging
 
+// Level is the severity of a log entry, which is ordered as
+// LevelNotice < LevelWarning < LevelError < LevelCritical
 type Level string
 
 const (
@@ -12,3 +14,18 @@ const (
 func (l Level) IsSevere() bool {
 	return l == LevelCritical || l == LevelError || l == LevelWarning
 }
+
+// severity returns the ordering of the level i.e. LevelNotice < LevelWarning < LevelError < LevelCritical.
+// An unknown level is considered the most severe, so that it's never filtered out
+func (l Level) severity() int {
+	switch l {
+	case LevelNotice:
+		return 0
+	case LevelWarning:
+		return 1
+	case LevelError:
+		return 2
+	default:
+		return 3
+	}
+}
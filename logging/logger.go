@@ -7,4 +7,7 @@ type Logger interface {
 	Fatal(err error, code int, args ...any)
 	LogError(err error, level Level, args ...any)
 	Log(msg string, level Level, args ...any)
+
+	// With returns a child logger, which includes the arguments in every log entry
+	With(args ...any) Logger
 }
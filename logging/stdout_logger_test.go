@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/errors"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_StdoutLogger_With(t *testing.T) {
+	var buf bytes.Buffer
+	logger := &StdoutLogger{
+		JSONLogger: NewJSONLogger(&buf, LevelNotice),
+	}
+
+	child := logger.With("request-id", "1234")
+	child.With("user-id", "5678").Log("grandchild", LevelNotice, "key", "value")
+	logger.Log("parent", LevelNotice)
+
+	entries := readEntries(t, &buf)
+	testhelpers.AssertEqual(t, len(entries), 2)
+
+	testhelpers.AssertEqual(t, entries[0]["@message"], any("grandchild"))
+	testhelpers.AssertEqual(t, entries[0]["request-id"], any("1234"))
+	testhelpers.AssertEqual(t, entries[0]["user-id"], any("5678"))
+	testhelpers.AssertEqual(t, entries[0]["key"], any("value"))
+
+	// The parent logger is not affected by the child logger arguments
+	testhelpers.AssertEqual(t, entries[1]["@message"], any("parent"))
+	_, ok := entries[1]["request-id"]
+	testhelpers.AssertEqual(t, ok, false)
+}
+
+func Test_StdoutLogger_LogError(t *testing.T) {
+	var buf bytes.Buffer
+	logger := &StdoutLogger{
+		JSONLogger: NewJSONLogger(&buf, LevelNotice),
+	}
+
+	err := errors.Wrap(errors.New("error", "inner", "1", "overridden", "error-arg"), "wrapped", "outer", "2")
+	logger.LogError(err, LevelError, "overridden", "log-arg")
+
+	entries := readEntries(t, &buf)
+	testhelpers.AssertEqual(t, len(entries), 1)
+	testhelpers.AssertEqual(t, entries[0]["@message"], any("error"))
+	testhelpers.AssertEqual(t, entries[0]["inner"], any("1"))
+	testhelpers.AssertEqual(t, entries[0]["outer"], any("2"))
+
+	// The log arguments take precedence over the error arguments
+	testhelpers.AssertEqual(t, entries[0]["overridden"], any("log-arg"))
+}
@@ -0,0 +1,20 @@
+package service
+
+// Chain composes the middleware functions into a single middleware function.
+// The middleware functions are applied in the order they are listed, meaning the first
+// is the outermost i.e. Chain(m1, m2, m3)(h) is the same as m1(m2(m3(h))),
+// so m1 runs first for a request and last for the response
+func Chain(middlewares ...MiddlewareFunc) MiddlewareFunc {
+	return func(handler Handler) Handler {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			handler = middlewares[i](handler)
+		}
+		return handler
+	}
+}
+
+// Apply wraps the handler with the middleware functions, in which the first is the outermost.
+// See Chain() for more details on the ordering
+func Apply(handler Handler, middlewares ...MiddlewareFunc) Handler {
+	return Chain(middlewares...)(handler)
+}
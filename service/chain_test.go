@@ -0,0 +1,40 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_Chain(t *testing.T) {
+	var names []string
+	record := func(name string) MiddlewareFunc {
+		return func(next Handler) Handler {
+			return HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+				names = append(names, name+":before")
+				err := next.ServeHTTP(w, r)
+				names = append(names, name+":after")
+				return err
+			})
+		}
+	}
+
+	h := Apply(HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		names = append(names, "handler")
+		return nil
+	}), record("m1"), Chain(record("m2"), record("m3")))
+
+	err := h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, names, []string{
+		"m1:before",
+		"m2:before",
+		"m3:before",
+		"handler",
+		"m3:after",
+		"m2:after",
+		"m1:after",
+	})
+}
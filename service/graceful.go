@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// RunGraceful starts the HTTP server and waits for either the context to be done or an interrupt/terminate signal,
+// then gracefully shuts down the server, allowing in-flight requests to complete.
+// If the shutdown timeout is exceeded, then the server is forcefully closed.
+// The first meaningful error is returned i.e. a closed server is not considered an error
+func RunGraceful(ctx context.Context, srv *http.Server, shutdownTimeout time.Duration) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.ListenAndServe()
+	}()
+
+	// Wait for either the context to be done or an error from the "ListenAndServe()" function
+	select {
+	case <-ctx.Done():
+	case err := <-errCh:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("server unexpectedly closed: %w", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		err = fmt.Errorf("unable to gracefully shutdown the server: %w", err)
+		if closeErr := srv.Close(); closeErr != nil {
+			return errors.Join(err, fmt.Errorf("unable to close the server: %w", closeErr))
+		}
+		return err
+	}
+
+	// Ignore the error if nil or is a server closed error, which is returned once "Shutdown()" is called
+	if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("server unexpectedly closed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_RunGraceful(t *testing.T) {
+	srv := &http.Server{
+		Addr:              "127.0.0.1:0",
+		ReadHeaderTimeout: time.Second,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := RunGraceful(ctx, srv, time.Second)
+	testhelpers.AssertNoError(t, err)
+}
+
+func Test_RunGraceful_ListenError(t *testing.T) {
+	srv := &http.Server{
+		Addr:              "invalid-address",
+		ReadHeaderTimeout: time.Second,
+	}
+
+	err := RunGraceful(context.Background(), srv, time.Second)
+	testhelpers.AssertError(t, err)
+}
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// PrincipalContextKey is the request context key, which the authenticated principal is stored under
+const PrincipalContextKey contextKey = "principal"
+
+// NewBasicAuth authenticates the request using the "Authorization: Basic" header.
+// On success the username is stored in the request context as the principal,
+// otherwise the "WWW-Authenticate" header is set and an error with the status code 401 is returned.
+//
+// NOTE: The verify function should compare the credentials in constant time e.g. using crypto/subtle
+func NewBasicAuth(verify func(user, pass string) bool) service.MiddlewareFunc {
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			user, pass, ok := r.BasicAuth()
+			if !ok || !verify(user, pass) {
+				w.Header().Set("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
+				return errUnauthorized()
+			}
+
+			ctx := context.WithValue(r.Context(), PrincipalContextKey, user)
+			r = r.WithContext(ctx)
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// NewBearerAuth authenticates the request using the "Authorization: Bearer" header.
+// On success the principal returned by the verify function is stored in the request context,
+// otherwise the "WWW-Authenticate" header is set and an error with the status code 401 is returned
+func NewBearerAuth(verify func(token string) (any, bool)) service.MiddlewareFunc {
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			token, ok := bearerToken(r)
+			var principal any
+			if ok {
+				principal, ok = verify(token)
+			}
+			if !ok {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				return errUnauthorized()
+			}
+
+			ctx := context.WithValue(r.Context(), PrincipalContextKey, principal)
+			r = r.WithContext(ctx)
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PrincipalFromContext returns the principal stored in the context by the authentication middleware
+func PrincipalFromContext(ctx context.Context) (any, bool) {
+	principal := ctx.Value(PrincipalContextKey)
+	return principal, principal != nil
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func errUnauthorized() error {
+	return service.NewError(errors.New(http.StatusText(http.StatusUnauthorized)), http.StatusUnauthorized)
+}
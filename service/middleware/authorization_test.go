@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewBasicAuth(t *testing.T) {
+	var principal any
+	h := NewBasicAuth(func(user, pass string) bool {
+		return user == "user" && pass == "pass"
+	})(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		principal, _ = PrincipalFromContext(r.Context())
+		return nil
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("user", "pass")
+	err := h.ServeHTTP(httptest.NewRecorder(), r)
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, principal, any("user"))
+
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r.SetBasicAuth("user", "invalid")
+	w := httptest.NewRecorder()
+	err = h.ServeHTTP(w, r)
+	assertStatusError(t, err, http.StatusUnauthorized)
+	testhelpers.AssertEqual(t, w.Header().Get("WWW-Authenticate"), `Basic realm="restricted", charset="UTF-8"`)
+}
+
+func Test_NewBearerAuth(t *testing.T) {
+	var principal any
+	h := NewBearerAuth(func(token string) (any, bool) {
+		return map[string]any{"userId": 1}, token == "valid-token"
+	})(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		principal, _ = PrincipalFromContext(r.Context())
+		return nil
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Authorization", "Bearer valid-token")
+	err := h.ServeHTTP(httptest.NewRecorder(), r)
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, principal, any(map[string]any{"userId": 1}))
+
+	for _, authorization := range []string{"", "Bearer", "Basic valid-token", "Bearer invalid-token"} {
+		r = httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", authorization)
+		w := httptest.NewRecorder()
+		err = h.ServeHTTP(w, r)
+		assertStatusError(t, err, http.StatusUnauthorized)
+		testhelpers.AssertEqual(t, w.Header().Get("WWW-Authenticate"), "Bearer")
+	}
+}
+
+func assertStatusError(t *testing.T, err error, statusCode int) {
+	t.Helper()
+
+	var e service.Error
+	testhelpers.AssertEqual(t, errors.As(err, &e), true)
+	testhelpers.AssertEqual(t, e.Status(), statusCode)
+}
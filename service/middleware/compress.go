@@ -0,0 +1,181 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// compressibleContentTypes are the content types (or prefixes ending with "/") worth compressing.
+// Already compressed content types e.g. images, are therefore skipped
+var compressibleContentTypes = []string{
+	"text/",
+	"application/javascript",
+	"application/json",
+	"application/xml",
+	"application/xhtml+xml",
+	"application/x-javascript",
+	"image/svg+xml",
+}
+
+// NewCompress compresses the response using gzip with the provided compression level e.g. gzip.DefaultCompression,
+// when the client supports it and the content type is compressible.
+// It panics if the compression level is invalid
+func NewCompress(level int) service.MiddlewareFunc {
+	if _, err := gzip.NewWriterLevel(io.Discard, level); err != nil {
+		panic(fmt.Errorf("invalid compression level: %w", err))
+	}
+	pool := &sync.Pool{
+		New: func() any {
+			gz, _ := gzip.NewWriterLevel(io.Discard, level)
+			return gz
+		},
+	}
+
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			w.Header().Add("Vary", "Accept-Encoding")
+			if r.Method == http.MethodHead || !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+				return next.ServeHTTP(w, r)
+			}
+
+			gw := &gzipResponseWriter{
+				ResponseWriter: w,
+				pool:           pool,
+			}
+
+			// Ensure the gzip writer is flushed and closed, even when the handler returns an error
+			defer gw.close()
+			return next.ServeHTTP(gw, r)
+		})
+	}
+}
+
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	pool *sync.Pool
+	gz   *gzip.Writer
+
+	statusCode    int
+	headerWritten bool
+}
+
+func (gw *gzipResponseWriter) WriteHeader(statusCode int) {
+	if gw.headerWritten || gw.statusCode != 0 {
+		return
+	}
+
+	// Delay writing the header until the first write, as the content type may not be known yet
+	gw.statusCode = statusCode
+}
+
+func (gw *gzipResponseWriter) Write(b []byte) (int, error) {
+	if !gw.headerWritten {
+		gw.start(b)
+	}
+
+	if gw.gz != nil {
+		return gw.gz.Write(b)
+	}
+	return gw.ResponseWriter.Write(b)
+}
+
+// FlushError is used by http.ResponseController to flush the response, in which the data buffered by
+// the gzip writer is flushed before the original http.ResponseWriter
+func (gw *gzipResponseWriter) FlushError() error {
+	if !gw.headerWritten {
+		gw.start(nil)
+	}
+
+	if gw.gz != nil {
+		if err := gw.gz.Flush(); err != nil {
+			return err
+		}
+	}
+	return http.NewResponseController(gw.ResponseWriter).Flush()
+}
+
+// Flush implements http.Flusher
+func (gw *gzipResponseWriter) Flush() {
+	_ = gw.FlushError()
+}
+
+// Unwrap is used by http.ResponseController to access the original http.ResponseWriter
+func (gw *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return gw.ResponseWriter
+}
+
+// start determines whether to compress the response and writes the header.
+// The content type is detected from the first bytes written, if not set
+func (gw *gzipResponseWriter) start(b []byte) {
+	h := gw.Header()
+	if h.Get("Content-Type") == "" && len(b) > 0 {
+		h.Set("Content-Type", http.DetectContentType(b))
+	}
+	if h.Get("Content-Encoding") == "" && isCompressible(h.Get("Content-Type")) && bodyAllowedForStatus(gw.statusCode) {
+		h.Del("Content-Length")
+		h.Set("Content-Encoding", "gzip")
+
+		gw.gz = gw.pool.Get().(*gzip.Writer)
+		gw.gz.Reset(gw.ResponseWriter)
+	}
+	gw.writeHeader()
+}
+
+func (gw *gzipResponseWriter) writeHeader() {
+	gw.headerWritten = true
+	if gw.statusCode != 0 {
+		gw.ResponseWriter.WriteHeader(gw.statusCode)
+	}
+}
+
+func (gw *gzipResponseWriter) close() {
+	// Write the delayed header, if the handler didn't write a body
+	if !gw.headerWritten && gw.statusCode != 0 {
+		gw.writeHeader()
+	}
+	if gw.gz != nil {
+		_ = gw.gz.Close()
+		gw.gz.Reset(io.Discard)
+		gw.pool.Put(gw.gz)
+		gw.gz = nil
+	}
+}
+
+func acceptsGzip(acceptEncoding string) bool {
+	for _, enc := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(enc), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), "gzip") {
+			continue
+		}
+
+		// Quality of 0 means not acceptable e.g. "gzip;q=0"
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			q, err := strconv.ParseFloat(v, 64)
+			return err == nil && q > 0
+		}
+		return true
+	}
+	return false
+}
+
+func isCompressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	for _, ct := range compressibleContentTypes {
+		if strings.HasSuffix(ct, "/") && strings.HasPrefix(mediaType, ct) || mediaType == ct {
+			return true
+		}
+	}
+	return false
+}
+
+func bodyAllowedForStatus(statusCode int) bool {
+	return statusCode != http.StatusNoContent && statusCode != http.StatusNotModified && (statusCode == 0 || statusCode >= 200)
+}
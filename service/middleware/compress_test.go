@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewCompress(t *testing.T) {
+	body := strings.Repeat("compress me ", 100)
+	errHandler := errors.New("handler error")
+
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		contentType    string
+		err            error
+		wantGzip       bool
+	}{
+		{
+			name:           "compressible content type",
+			acceptEncoding: "gzip, deflate",
+			contentType:    "text/plain; charset=utf-8",
+			wantGzip:       true,
+		},
+		{
+			name:           "compressible content type when the handler returns an error",
+			acceptEncoding: "gzip",
+			contentType:    "application/json",
+			err:            errHandler,
+			wantGzip:       true,
+		},
+		{
+			name:           "detected content type",
+			acceptEncoding: "gzip",
+			contentType:    "",
+			wantGzip:       true,
+		},
+		{
+			name:           "already compressed content type",
+			acceptEncoding: "gzip",
+			contentType:    "image/png",
+			wantGzip:       false,
+		},
+		{
+			name:           "gzip not accepted",
+			acceptEncoding: "gzip;q=0, deflate",
+			contentType:    "text/plain",
+			wantGzip:       false,
+		},
+		{
+			name:           "no accept encoding",
+			acceptEncoding: "",
+			contentType:    "text/plain",
+			wantGzip:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewCompress(gzip.DefaultCompression)(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				w.Header().Set("Content-Length", "1200")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(body))
+				return tt.err
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			w := httptest.NewRecorder()
+
+			err := h.ServeHTTP(w, r)
+			testhelpers.AssertEqual(t, err, tt.err)
+			testhelpers.AssertEqual(t, w.Code, http.StatusCreated)
+			testhelpers.AssertEqual(t, w.Header().Get("Vary"), "Accept-Encoding")
+
+			if !tt.wantGzip {
+				testhelpers.AssertEqual(t, w.Header().Get("Content-Encoding"), "")
+				testhelpers.AssertEqual(t, w.Body.String(), body)
+				return
+			}
+
+			testhelpers.AssertEqual(t, w.Header().Get("Content-Encoding"), "gzip")
+			testhelpers.AssertEqual(t, w.Header().Get("Content-Length"), "")
+
+			gz, err := gzip.NewReader(w.Body)
+			testhelpers.AssertNoError(t, err)
+			b, err := io.ReadAll(gz)
+			testhelpers.AssertNoError(t, err)
+			testhelpers.AssertEqual(t, string(b), body)
+		})
+	}
+}
+
+func Test_NewCompress_Flush(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	var flushed []byte
+	h := NewCompress(gzip.DefaultCompression)(service.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) error {
+		rw.Header().Set("Content-Type", "text/event-stream")
+		_, _ = rw.Write([]byte("data: 1\n\n"))
+		if err := http.NewResponseController(rw).Flush(); err != nil {
+			return err
+		}
+
+		// The flushed data must be decompressable before the gzip writer is closed
+		gz, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+		if err != nil {
+			return err
+		}
+		flushed, _ = io.ReadAll(gz)
+		return nil
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Encoding", "gzip")
+
+	err := h.ServeHTTP(w, r)
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, w.Flushed, true)
+	testhelpers.AssertEqual(t, w.Header().Get("Content-Encoding"), "gzip")
+	testhelpers.AssertEqual(t, string(flushed), "data: 1\n\n")
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// CORSOptions are the options for the CORS middleware
+type CORSOptions struct {
+	// AllowedOrigins are the origins allowed to make cross-origin requests. An origin can be "*" to allow
+	// any origin, an exact match e.g. "https://example.com" or contain a single wildcard
+	// e.g. "https://*.example.com"
+	AllowedOrigins []string
+
+	// AllowedMethods are the methods allowed for cross-origin requests.
+	// Defaults to "GET", "HEAD" and "POST" when empty
+	AllowedMethods []string
+
+	// AllowedHeaders are the non-simple headers allowed for cross-origin requests
+	AllowedHeaders []string
+
+	// AllowCredentials indicates whether the request can include credentials e.g. cookies.
+	// The allowed origins must be explicit when enabled, as allowing any origin with "*" would let every
+	// site make credentialed requests. NewCORS panics if both are set
+	AllowCredentials bool
+
+	// MaxAge is how long the results of a preflight request can be cached. Not set when 0
+	MaxAge time.Duration
+}
+
+// NewCORS adds the "Access-Control-*" headers to responses of cross-origin requests from an allowed origin.
+// Preflight requests are handled without calling the next handler, responding with the status code 204.
+// No "Access-Control-*" headers are added for an origin which is not allowed, so the browser blocks the response.
+// It panics if the allowed origins contain "*" and credentials are allowed
+func NewCORS(opts CORSOptions) service.MiddlewareFunc {
+	allowAnyOrigin := slices.Contains(opts.AllowedOrigins, "*")
+	if allowAnyOrigin && opts.AllowCredentials {
+		panic("invalid CORS options, credentials can't be allowed for any origin")
+	}
+
+	methods := opts.AllowedMethods
+	if len(methods) == 0 {
+		methods = []string{http.MethodGet, http.MethodHead, http.MethodPost}
+	}
+	var (
+		allowedMethods = strings.Join(methods, ", ")
+		allowedHeaders = strings.Join(opts.AllowedHeaders, ", ")
+	)
+
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			h := w.Header()
+			h.Add("Vary", "Origin")
+
+			origin := r.Header.Get("Origin")
+			isPreflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+			if isPreflight {
+				h.Add("Vary", "Access-Control-Request-Method")
+				h.Add("Vary", "Access-Control-Request-Headers")
+			}
+
+			if origin != "" && isAllowedOrigin(opts.AllowedOrigins, origin) {
+				if allowAnyOrigin {
+					h.Set("Access-Control-Allow-Origin", "*")
+				} else {
+					h.Set("Access-Control-Allow-Origin", origin)
+				}
+				if opts.AllowCredentials {
+					h.Set("Access-Control-Allow-Credentials", "true")
+				}
+				if isPreflight {
+					h.Set("Access-Control-Allow-Methods", allowedMethods)
+					if allowedHeaders != "" {
+						h.Set("Access-Control-Allow-Headers", allowedHeaders)
+					}
+					if opts.MaxAge > 0 {
+						h.Set("Access-Control-Max-Age", strconv.Itoa(int(opts.MaxAge.Seconds())))
+					}
+				}
+			}
+
+			if isPreflight {
+				w.WriteHeader(http.StatusNoContent)
+				return nil
+			}
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isAllowedOrigin(allowedOrigins []string, origin string) bool {
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+
+		prefix, suffix, ok := strings.Cut(allowed, "*")
+		if ok && len(origin) >= len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+			return true
+		}
+	}
+	return false
+}
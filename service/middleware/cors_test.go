@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewCORS(t *testing.T) {
+	var called bool
+	h := NewCORS(CORSOptions{
+		AllowedOrigins:   []string{"https://example.com", "https://*.example.org"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPut},
+		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	})(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		called = true
+		return nil
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		origin      string
+		preflight   bool
+		wantCalled  bool
+		wantCode    int
+		wantHeaders map[string]string
+	}{
+		{
+			name:       "preflight",
+			method:     http.MethodOptions,
+			origin:     "https://example.com",
+			preflight:  true,
+			wantCalled: false,
+			wantCode:   http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://example.com",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Allow-Methods":     "GET, PUT",
+				"Access-Control-Allow-Headers":     "Content-Type, Authorization",
+				"Access-Control-Max-Age":           "600",
+			},
+		},
+		{
+			name:       "preflight with a disallowed origin",
+			method:     http.MethodOptions,
+			origin:     "https://evil.com",
+			preflight:  true,
+			wantCalled: false,
+			wantCode:   http.StatusNoContent,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "",
+				"Access-Control-Allow-Methods": "",
+			},
+		},
+		{
+			name:       "actual request with a wildcard origin",
+			method:     http.MethodGet,
+			origin:     "https://api.example.org",
+			wantCalled: true,
+			wantCode:   http.StatusOK,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://api.example.org",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Allow-Methods":     "",
+			},
+		},
+		{
+			name:       "actual request with a disallowed origin",
+			method:     http.MethodGet,
+			origin:     "https://example.org.evil.com",
+			wantCalled: true,
+			wantCode:   http.StatusOK,
+			wantHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = false
+			r := httptest.NewRequest(tt.method, "/", nil)
+			r.Header.Set("Origin", tt.origin)
+			if tt.preflight {
+				r.Header.Set("Access-Control-Request-Method", http.MethodPut)
+			}
+			w := httptest.NewRecorder()
+
+			err := h.ServeHTTP(w, r)
+			testhelpers.AssertNoError(t, err)
+			testhelpers.AssertEqual(t, called, tt.wantCalled)
+			testhelpers.AssertEqual(t, w.Code, tt.wantCode)
+			for k, want := range tt.wantHeaders {
+				testhelpers.AssertEqual(t, w.Header().Get(k), want)
+			}
+		})
+	}
+}
+
+func Test_NewCORS_AnyOriginWithCredentials(t *testing.T) {
+	defer func() {
+		testhelpers.AssertEqual(t, recover() != nil, true)
+	}()
+	NewCORS(CORSOptions{
+		AllowedOrigins:   []string{"https://example.com", "*"},
+		AllowCredentials: true,
+	})
+}
+
+func Test_NewCORS_AnyOrigin(t *testing.T) {
+	h := NewCORS(CORSOptions{
+		AllowedOrigins: []string{"*"},
+	})(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return nil
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Origin", "https://any.example.net")
+	w := httptest.NewRecorder()
+
+	err := h.ServeHTTP(w, r)
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, w.Header().Get("Access-Control-Allow-Origin"), "*")
+	testhelpers.AssertEqual(t, w.Header().Get("Access-Control-Allow-Credentials"), "")
+}
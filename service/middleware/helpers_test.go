@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/softwarespot/go-helpers/errors"
+	"github.com/softwarespot/go-helpers/logging"
+)
+
+// Ensure interface compatibility
+var _ logging.Logger = &captureLogger{}
+
+type capturedEntry struct {
+	msg   string
+	level logging.Level
+	args  map[string]any
+}
+
+// captureLogger is a logger which captures the log entries, so they can be asserted
+type captureLogger struct {
+	mu      sync.Mutex
+	entries []capturedEntry
+}
+
+func (cl *captureLogger) Fatal(err error, code int, args ...any) {
+	cl.LogError(err, logging.LevelCritical, args...)
+}
+
+func (cl *captureLogger) LogError(err error, level logging.Level, args ...any) {
+	cl.Log(err.Error(), level, slices.Concat(errors.Args(err), args)...)
+}
+
+func (cl *captureLogger) Log(msg string, level logging.Level, args ...any) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	entry := capturedEntry{
+		msg:   msg,
+		level: level,
+		args:  make(map[string]any),
+	}
+	for i := 0; i+1 < len(args); i += 2 {
+		if key, ok := args[i].(string); ok {
+			entry.args[key] = args[i+1]
+		}
+	}
+	cl.entries = append(cl.entries, entry)
+}
+
+func (cl *captureLogger) With(args ...any) logging.Logger {
+	return cl
+}
+
+// Entries returns the captured log entries, excluding the "loaded ... middleware" entries
+func (cl *captureLogger) Entries() []capturedEntry {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+
+	return slices.DeleteFunc(slices.Clone(cl.entries), func(entry capturedEntry) bool {
+		return entry.level == logging.LevelNotice && len(entry.args) == 0
+	})
+}
@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// RateLimiterKeyFunc returns the key, which requests are rate limited by e.g. the client IP or an API key
+type RateLimiterKeyFunc func(r *http.Request) string
+
+// RateLimiterOption is an option for NewRateLimiter
+type RateLimiterOption func(*rateLimiter)
+
+// WithRateLimiterKeyFunc sets the function used to key the requests. The default is the client IP
+func WithRateLimiterKeyFunc(fn RateLimiterKeyFunc) RateLimiterOption {
+	return func(rl *rateLimiter) {
+		rl.keyFn = fn
+	}
+}
+
+// NewRateLimiter limits each client to the requests per second, allowing bursts of up to burst requests,
+// using a token bucket per key. When the limit is exceeded, the "Retry-After" header is set
+// and an error with the status code 429 is returned.
+// Idle buckets are periodically removed whilst handling requests.
+// It panics if either rps or burst is not greater than 0
+func NewRateLimiter(rps float64, burst int, opts ...RateLimiterOption) service.MiddlewareFunc {
+	if rps <= 0 {
+		panic("invalid rate limiter requests per second, expected greater than 0")
+	}
+	if burst <= 0 {
+		panic("invalid rate limiter burst, expected greater than 0")
+	}
+
+	rl := &rateLimiter{
+		rps:         rps,
+		burst:       float64(burst),
+		keyFn:       clientIP,
+		buckets:     make(map[string]*tokenBucket),
+		lastCleanup: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(rl)
+	}
+
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			if retryAfter, ok := rl.allow(rl.keyFn(r), time.Now()); !ok {
+				secs := int(math.Ceil(retryAfter.Seconds()))
+				w.Header().Set("Retry-After", strconv.Itoa(max(secs, 1)))
+				return service.NewError(errors.New(http.StatusText(http.StatusTooManyRequests)), http.StatusTooManyRequests)
+			}
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type rateLimiter struct {
+	rps   float64
+	burst float64
+	keyFn RateLimiterKeyFunc
+
+	mu          sync.Mutex
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// allow takes a token from the bucket for the key, otherwise it returns how long until a token is available
+func (rl *rateLimiter) allow(key string, now time.Time) (time.Duration, bool) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.cleanup(now)
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &tokenBucket{
+			tokens: rl.burst,
+			last:   now,
+		}
+		rl.buckets[key] = b
+	}
+
+	b.tokens = min(rl.burst, b.tokens+now.Sub(b.last).Seconds()*rl.rps)
+	b.last = now
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / rl.rps * float64(time.Second)), false
+	}
+	b.tokens--
+	return 0, true
+}
+
+// cleanup removes the buckets, which have been idle long enough to be full again,
+// as they are the same as a newly created bucket
+func (rl *rateLimiter) cleanup(now time.Time) {
+	refill := time.Duration(rl.burst / rl.rps * float64(time.Second))
+	if now.Sub(rl.lastCleanup) < max(refill, time.Minute) {
+		return
+	}
+	rl.lastCleanup = now
+
+	for key, b := range rl.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewRateLimiter(t *testing.T) {
+	h := NewRateLimiter(1, 2, WithRateLimiterKeyFunc(func(r *http.Request) string {
+		return r.Header.Get("X-API-Key")
+	}))(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return nil
+	}))
+
+	serve := func(apiKey string) (*httptest.ResponseRecorder, error) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("X-API-Key", apiKey)
+		w := httptest.NewRecorder()
+		return w, h.ServeHTTP(w, r)
+	}
+
+	// Burst
+	for range 2 {
+		_, err := serve("key-1")
+		testhelpers.AssertNoError(t, err)
+	}
+
+	w, err := serve("key-1")
+	var e service.Error
+	testhelpers.AssertEqual(t, errors.As(err, &e), true)
+	testhelpers.AssertEqual(t, e.Status(), http.StatusTooManyRequests)
+	testhelpers.AssertEqual(t, w.Header().Get("Retry-After"), "1")
+
+	// A different key has its own bucket
+	_, err = serve("key-2")
+	testhelpers.AssertNoError(t, err)
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/softwarespot/go-helpers/errors"
+	"github.com/softwarespot/go-helpers/helpers"
+	"github.com/softwarespot/go-helpers/logging"
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// NewRecover recovers from a panic in the next handler, returning an error with the status code 500,
+// which the server then logs and writes as the response.
+// The error message is the generic status text, so the panic value isn't leaked to the client.
+// The panic value and stack trace are attached to the error as the "panic" and "stack-trace" arguments,
+// so they're included when logged
+func NewRecover(logger logging.Logger) service.MiddlewareFunc {
+	logger.Log("loaded recover middleware", logging.LevelNotice)
+
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) (err error) {
+			defer helpers.RecoverFuncWithStack(func(panicErr error, stack []byte) {
+				err = service.NewError(errors.WrapWithMessage(
+					panicErr,
+					http.StatusText(http.StatusInternalServerError),
+					"panic", panicErr.Error(),
+					"stack-trace", string(stack),
+				), http.StatusInternalServerError)
+			})
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/errors"
+	"github.com/softwarespot/go-helpers/logging"
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewRecover(t *testing.T) {
+	logger := &captureLogger{}
+	h := NewRecover(logger)(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		panic("handler panic")
+	}))
+
+	err := h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	assertStatusError(t, err, http.StatusInternalServerError)
+	testhelpers.AssertEqual(t, err.Error(), http.StatusText(http.StatusInternalServerError))
+	testhelpers.AssertEqual(t, errors.Cause(err).Error(), "handler panic")
+
+	var e service.Error
+	testhelpers.AssertEqual(t, errors.As(err, &e), true)
+	testhelpers.AssertEqual(t, e.Unwrap().Error(), http.StatusText(http.StatusInternalServerError))
+
+	// The middleware doesn't log, as the server logs the returned error
+	testhelpers.AssertEqual(t, len(logger.Entries()), 0)
+
+	logger.LogError(err, logging.LevelError)
+	entries := logger.Entries()
+	testhelpers.AssertEqual(t, len(entries), 1)
+	testhelpers.AssertEqual(t, entries[0].args["panic"], any("handler panic"))
+	stack, _ := entries[0].args["stack-trace"].(string)
+	testhelpers.AssertEqual(t, strings.Contains(stack, "Test_NewRecover"), true)
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/softwarespot/go-helpers/helpers"
+	"github.com/softwarespot/go-helpers/service"
+)
+
+type contextKey string
+
+// RequestIDContextKey is the request context key, which the request ID is stored under
+const RequestIDContextKey contextKey = "request-id"
+
+// RequestIDHeader is the request and response header containing the request ID
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength is the maximum length of an incoming request ID
+const maxRequestIDLength = 128
+
+// NewRequestID tags each request with a request ID, which is taken from the incoming "X-Request-ID" header,
+// or generated when not provided or invalid i.e. longer than 128 bytes or containing characters
+// other than "A-Z", "a-z", "0-9", ".", "_" and "-". The request ID is stored in the request context
+// and returned in the "X-Request-ID" response header
+func NewRequestID() service.MiddlewareFunc {
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			id := r.Header.Get(RequestIDHeader)
+			if !isValidRequestID(id) {
+				var err error
+				if id, err = helpers.ID[string](); err != nil {
+					return fmt.Errorf("unable to create the request ID: %w", err)
+				}
+			}
+
+			w.Header().Set(RequestIDHeader, id)
+			ctx := context.WithValue(r.Context(), RequestIDContextKey, id)
+			r = r.WithContext(ctx)
+			return next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range []byte(id) {
+		isValid := c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '.' || c == '_' || c == '-'
+		if !isValid {
+			return false
+		}
+	}
+	return true
+}
+
+// RequestIDFromContext returns the request ID stored in the context by the request ID middleware
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(RequestIDContextKey).(string)
+	return id, ok
+}
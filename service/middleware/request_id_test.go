@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewRequestID(t *testing.T) {
+	tests := []struct {
+		name          string
+		incomingID    string
+		wantGenerated bool
+	}{
+		{
+			name:          "generated when not provided",
+			incomingID:    "",
+			wantGenerated: true,
+		},
+		{
+			name:          "incoming ID is echoed back",
+			incomingID:    "abc-123_DEF.456",
+			wantGenerated: false,
+		},
+		{
+			name:          "incoming ID with invalid characters is replaced",
+			incomingID:    "abc\r\nX-Injected: 1",
+			wantGenerated: true,
+		},
+		{
+			name:          "incoming ID which is too long is replaced",
+			incomingID:    strings.Repeat("a", 129),
+			wantGenerated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctxID string
+				ctxOk bool
+			)
+			h := NewRequestID()(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+				ctxID, ctxOk = RequestIDFromContext(r.Context())
+				return nil
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set(RequestIDHeader, tt.incomingID)
+			w := httptest.NewRecorder()
+
+			err := h.ServeHTTP(w, r)
+			testhelpers.AssertNoError(t, err)
+
+			id := w.Header().Get(RequestIDHeader)
+			testhelpers.AssertEqual(t, ctxOk, true)
+			testhelpers.AssertEqual(t, ctxID, id)
+			if tt.wantGenerated {
+				testhelpers.AssertEqual(t, regexp.MustCompile("^[0-9a-f]{64}$").MatchString(id), true)
+			} else {
+				testhelpers.AssertEqual(t, id, tt.incomingID)
+			}
+		})
+	}
+}
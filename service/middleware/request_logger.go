@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/softwarespot/go-helpers/logging"
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// NewRequestLogger logs each request with the status code, response size, duration and handler error (if any)
+func NewRequestLogger(logger logging.Logger) service.MiddlewareFunc {
+	logger.Log("loaded request logger middleware", logging.LevelNotice)
+
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			t0 := time.Now()
+			rr := newResponseRecorder(w)
+			err := next.ServeHTTP(rr, r)
+			args := []any{
+				"path", r.URL.Path,
+				"status-code", rr.Status(err),
+				"response-size", rr.size,
+				"took", time.Since(t0).String(),
+			}
+			if err != nil {
+				args = append(args, "error", err.Error())
+			}
+			logger.Log("handled request", logging.LevelNotice, service.Args(r, args...)...)
+			return err
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	goerrors "github.com/softwarespot/go-helpers/errors"
+	"github.com/softwarespot/go-helpers/logging"
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewRequestLogger(t *testing.T) {
+	errHandler := errors.New("handler error")
+	tests := []struct {
+		name           string
+		handler        func(w http.ResponseWriter, r *http.Request) error
+		wantStatusCode int
+		wantSize       int
+		wantErr        error
+	}{
+		{
+			name: "explicit status code",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				w.WriteHeader(http.StatusCreated)
+				_, err := w.Write([]byte("created"))
+				return err
+			},
+			wantStatusCode: http.StatusCreated,
+			wantSize:       7,
+		},
+		{
+			name: "implicit status code on write",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				_, err := w.Write([]byte("ok"))
+				return err
+			},
+			wantStatusCode: http.StatusOK,
+			wantSize:       2,
+		},
+		{
+			name: "nothing written",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				return nil
+			},
+			wantStatusCode: http.StatusOK,
+			wantSize:       0,
+		},
+		{
+			name: "status code derived from a service error",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				return service.NewError(errHandler, http.StatusNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantErr:        service.NewError(errHandler, http.StatusNotFound),
+		},
+		{
+			name: "status code derived from an error with status",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				return goerrors.WithStatus(errHandler, http.StatusConflict)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantErr:        errHandler,
+		},
+		{
+			name: "status code derived from an error without status",
+			handler: func(w http.ResponseWriter, r *http.Request) error {
+				return errHandler
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantErr:        errHandler,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			h := NewRequestLogger(logging.NewJSONLogger(&buf, logging.LevelNotice))(service.HandlerFunc(tt.handler))
+
+			err := h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/path?q=1", nil))
+			if tt.wantErr == nil {
+				testhelpers.AssertNoError(t, err)
+			} else {
+				testhelpers.AssertEqual(t, errors.Is(err, tt.wantErr), true)
+			}
+
+			// Skip the "loaded request logger middleware" entry
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			testhelpers.AssertEqual(t, len(lines), 2)
+
+			var entry map[string]any
+			testhelpers.AssertNoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+			testhelpers.AssertEqual(t, entry["@message"], any("handled request"))
+			testhelpers.AssertEqual(t, entry["http-method"], any(http.MethodPost))
+			testhelpers.AssertEqual(t, entry["path"], any("/path"))
+			testhelpers.AssertEqual(t, entry["status-code"], any(float64(tt.wantStatusCode)))
+			testhelpers.AssertEqual(t, entry["response-size"], any(float64(tt.wantSize)))
+			_, ok := entry["took"]
+			testhelpers.AssertEqual(t, ok, true)
+
+			errMsg, ok := entry["error"]
+			if tt.wantErr == nil {
+				testhelpers.AssertEqual(t, ok, false)
+			} else {
+				testhelpers.AssertEqual(t, errMsg, any(tt.wantErr.Error()))
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/softwarespot/go-helpers/errors"
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// responseRecorder wraps a http.ResponseWriter to record the status code and the number of bytes written
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	size       int
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		ResponseWriter: w,
+		statusCode:     0,
+		size:           0,
+	}
+}
+
+func (rr *responseRecorder) WriteHeader(statusCode int) {
+	if rr.statusCode == 0 {
+		rr.statusCode = statusCode
+	}
+	rr.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.statusCode == 0 {
+		rr.statusCode = http.StatusOK
+	}
+	n, err := rr.ResponseWriter.Write(b)
+	rr.size += n
+	return n, err
+}
+
+// Unwrap is used by http.ResponseController to access the original http.ResponseWriter
+func (rr *responseRecorder) Unwrap() http.ResponseWriter {
+	return rr.ResponseWriter
+}
+
+// Status returns the recorded status code. If nothing has been written yet, then the status code
+// is derived from the handler error, which is the status code the server will write
+func (rr *responseRecorder) Status(err error) int {
+	if rr.statusCode != 0 {
+		return rr.statusCode
+	}
+	if err == nil {
+		return http.StatusOK
+	}
+
+	var e service.Error
+	if errors.As(err, &e) {
+		return e.Status()
+	}
+	return errors.StatusOr(err, http.StatusInternalServerError)
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/softwarespot/go-helpers/service"
+)
+
+// NewTimeout runs the next handler with a request context deadline of the provided duration.
+// If the handler doesn't finish in time, then a http.ErrHandlerTimeout error with the status code 503 is returned.
+// If the request context is done for another reason e.g. the client disconnected, then the context error is returned.
+//
+// The response is buffered until the handler finishes, so that it isn't written to concurrently
+// once timed out.
+//
+// NOTE: The handler continues running in its own go routine after timing out,
+// therefore it should respect the request context being done to avoid wasting resources.
+// A panic in the handler is re-panicked in the request go routine, unless it occurs after the handler
+// has timed out, in which case it's recovered and discarded
+func NewTimeout(d time.Duration) service.MiddlewareFunc {
+	return func(next service.Handler) service.Handler {
+		return service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+			ctx, cancel := context.WithTimeout(r.Context(), d)
+			defer cancel()
+			r = r.WithContext(ctx)
+
+			var (
+				tw      = newTimeoutWriter()
+				errCh   = make(chan error, 1)
+				panicCh = make(chan any, 1)
+			)
+			go func() {
+				defer func() {
+					if p := recover(); p != nil {
+						panicCh <- p
+					}
+				}()
+				errCh <- next.ServeHTTP(tw, r)
+			}()
+
+			select {
+			case p := <-panicCh:
+				// Re-panic in the request go routine, so it can be recovered by other middleware
+				panic(p)
+			case err := <-errCh:
+				tw.writeTo(w)
+				return err
+			case <-ctx.Done():
+				tw.timeout()
+				if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return ctx.Err()
+				}
+				return service.NewError(fmt.Errorf("handler exceeded %s: %w", d, http.ErrHandlerTimeout), http.StatusServiceUnavailable)
+			}
+		})
+	}
+}
+
+// timeoutWriter buffers the response, which is discarded if the handler times out
+type timeoutWriter struct {
+	mu         sync.Mutex
+	header     http.Header
+	buf        bytes.Buffer
+	statusCode int
+	timedOut   bool
+}
+
+func newTimeoutWriter() *timeoutWriter {
+	return &timeoutWriter{
+		header:     make(http.Header),
+		statusCode: 0,
+		timedOut:   false,
+	}
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.statusCode == 0 {
+		tw.statusCode = http.StatusOK
+	}
+	return tw.buf.Write(b)
+}
+
+func (tw *timeoutWriter) WriteHeader(statusCode int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if tw.timedOut || tw.statusCode != 0 {
+		return
+	}
+	tw.statusCode = statusCode
+}
+
+func (tw *timeoutWriter) timeout() {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	tw.timedOut = true
+}
+
+func (tw *timeoutWriter) writeTo(w http.ResponseWriter) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	h := w.Header()
+	for k, v := range tw.header {
+		h[k] = v
+	}
+	if tw.statusCode != 0 {
+		w.WriteHeader(tw.statusCode)
+	}
+	if tw.buf.Len() > 0 {
+		_, _ = w.Write(tw.buf.Bytes())
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/softwarespot/go-helpers/service"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_NewTimeout(t *testing.T) {
+	h := NewTimeout(20 * time.Millisecond)(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusCreated)
+		_, err := w.Write([]byte("done"))
+		return err
+	}))
+
+	w := httptest.NewRecorder()
+	err := h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	testhelpers.AssertNoError(t, err)
+	testhelpers.AssertEqual(t, w.Code, http.StatusCreated)
+	testhelpers.AssertEqual(t, w.Header().Get("Content-Type"), "text/plain")
+	testhelpers.AssertEqual(t, w.Body.String(), "done")
+}
+
+func Test_NewTimeout_SlowHandler(t *testing.T) {
+	var (
+		release = make(chan struct{})
+		done    = make(chan error, 1)
+	)
+	h := NewTimeout(20 * time.Millisecond)(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		<-release
+		_, err := w.Write([]byte("too late"))
+		done <- err
+		return err
+	}))
+
+	w := httptest.NewRecorder()
+	err := h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	testhelpers.AssertEqual(t, errors.Is(err, http.ErrHandlerTimeout), true)
+
+	var e service.Error
+	testhelpers.AssertEqual(t, errors.As(err, &e), true)
+	testhelpers.AssertEqual(t, e.Status(), http.StatusServiceUnavailable)
+
+	// The handler still runs after timing out, but its response is discarded
+	close(release)
+	testhelpers.AssertEqual(t, <-done, http.ErrHandlerTimeout)
+	testhelpers.AssertEqual(t, w.Body.String(), "")
+}
+
+func Test_NewTimeout_CancelledRequest(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	h := NewTimeout(time.Second)(service.HandlerFunc(func(w http.ResponseWriter, r *http.Request) error {
+		<-release
+		return nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx))
+	testhelpers.AssertEqual(t, err, context.Canceled)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	goerrors "github.com/softwarespot/go-helpers/errors"
 	"github.com/softwarespot/go-helpers/logging"
 )
 
@@ -70,5 +71,10 @@ func getErrorStatus(err error) (string, int) {
 	if errors.As(err, &e) {
 		return e.Unwrap().Error(), e.Status()
 	}
+
+	// Fallback to the status code set using errors.WithStatus()
+	if statusCode, ok := goerrors.Status(err); ok {
+		return err.Error(), statusCode
+	}
 	return http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	goerrors "github.com/softwarespot/go-helpers/errors"
+	testhelpers "github.com/softwarespot/go-helpers/test-helpers"
+)
+
+func Test_getErrorStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantMsg        string
+		wantStatusCode int
+	}{
+		{
+			name:           "service error",
+			err:            NewError(errors.New("not found"), http.StatusNotFound),
+			wantMsg:        "not found",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "error with status",
+			err:            goerrors.Wrap(goerrors.WithStatus(goerrors.New("conflict"), http.StatusConflict), "wrapped"),
+			wantMsg:        "conflict",
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "service error takes precedence over an error with status",
+			err:            NewError(goerrors.WithStatus(errors.New("bad request"), http.StatusConflict), http.StatusBadRequest),
+			wantMsg:        "bad request",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "error without status",
+			err:            errors.New("internal details"),
+			wantMsg:        http.StatusText(http.StatusInternalServerError),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, statusCode := getErrorStatus(tt.err)
+			testhelpers.AssertEqual(t, msg, tt.wantMsg)
+			testhelpers.AssertEqual(t, statusCode, tt.wantStatusCode)
+		})
+	}
+}
@@ -99,11 +99,7 @@ func (s *Server) HandleErrorFunc(pattern string, handler func(w http.ResponseWri
 
 func (s *Server) applyMiddlewareHandlers(handler Handler) Handler {
 	// Ensure the middleware handlers are executed in the same order they were registered i.e. FIFO
-	for i := len(s.middlewares) - 1; i >= 0; i-- {
-		mwh := s.middlewares[i]
-		handler = mwh(handler)
-	}
-	return handler
+	return Apply(handler, s.middlewares...)
 }
 
 // ListenAndServe starts the HTTP server (in a separate go routine) and listens for incoming requests.